@@ -0,0 +1,129 @@
+package customerio
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// AnonymousIDStore is used to persist the anonymous id for a visitor (IE: a cookie, session or database)
+// The key is whatever identifies the visitor in your application (IE: a session id)
+type AnonymousIDStore interface {
+	Delete(ctx context.Context, key string) error                  // Delete will remove the anonymous id
+	Get(ctx context.Context, key string) (string, error)           // Get will return the anonymous id ("" if not found)
+	Set(ctx context.Context, key string, anonymousID string) error // Set will save the anonymous id
+}
+
+// MemoryAnonymousIDStore is an in-memory AnonymousIDStore (useful for testing or a single process)
+type MemoryAnonymousIDStore struct {
+	ids map[string]string
+	mu  sync.RWMutex
+}
+
+// NewMemoryAnonymousIDStore will return a new in-memory store
+func NewMemoryAnonymousIDStore() *MemoryAnonymousIDStore {
+	return &MemoryAnonymousIDStore{ids: make(map[string]string)}
+}
+
+// Delete will remove the anonymous id
+func (m *MemoryAnonymousIDStore) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.ids, key)
+	return nil
+}
+
+// Get will return the anonymous id ("" if not found)
+func (m *MemoryAnonymousIDStore) Get(_ context.Context, key string) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.ids[key], nil
+}
+
+// Set will save the anonymous id
+func (m *MemoryAnonymousIDStore) Set(_ context.Context, key string, anonymousID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ids[key] = anonymousID
+	return nil
+}
+
+// AnonymousTracker will generate and persist anonymous ids for visitors,
+// track their events, and associate the events with the person once they are identified
+type AnonymousTracker struct {
+	client *Client
+	store  AnonymousIDStore
+}
+
+// NewAnonymousTracker will return a new tracker using the store for the anonymous ids
+func (c *Client) NewAnonymousTracker(store AnonymousIDStore) *AnonymousTracker {
+	return &AnonymousTracker{
+		client: c,
+		store:  store,
+	}
+}
+
+// AnonymousID will return the anonymous id for the visitor (one is generated and saved if not found)
+func (a *AnonymousTracker) AnonymousID(ctx context.Context, key string) (string, error) {
+	if key == "" {
+		return "", ParamError{Param: "key"}
+	}
+	anonymousID, err := a.store.Get(ctx, key)
+	if err != nil || anonymousID != "" {
+		return anonymousID, err
+	}
+	if anonymousID, err = NewAnonymousID(); err != nil {
+		return "", err
+	}
+	if err = a.store.Set(ctx, key, anonymousID); err != nil {
+		return "", err
+	}
+	return anonymousID, nil
+}
+
+// TrackEvent will create a new event for the visitor using their anonymous id
+// Use "timestamp" to send events in the past. If not set, it will use Now().UTC()
+func (a *AnonymousTracker) TrackEvent(ctx context.Context, key, eventName string, timestamp time.Time,
+	data map[string]interface{}) error {
+	anonymousID, err := a.AnonymousID(ctx, key)
+	if err != nil {
+		return err
+	}
+	return a.client.TrackAnonymousEventWithContext(ctx, anonymousID, eventName, timestamp, data)
+}
+
+// Identify will add/update the customer and associate any events sent using the visitor's anonymous id
+// The anonymous id is removed from the store once the customer is identified
+func (a *AnonymousTracker) Identify(ctx context.Context, key, customerIDOrEmail string,
+	attributes map[string]interface{}) error {
+	if key == "" {
+		return ParamError{Param: "key"}
+	}
+	anonymousID, err := a.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	// Never tracked anonymously
+	if anonymousID == "" {
+		return a.client.UpdateCustomerWithContext(ctx, customerIDOrEmail, attributes)
+	}
+
+	if err = a.client.IdentifyAnonymousWithContext(ctx, customerIDOrEmail, anonymousID, attributes); err != nil {
+		return err
+	}
+	return a.store.Delete(ctx, key)
+}
+
+// NewAnonymousID will generate a new random anonymous id (UUID v4)
+func NewAnonymousID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40 // Version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // Variant 10
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
@@ -0,0 +1,250 @@
+package customerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// testAnonymousKey is the visitor key used when testing the anonymous tracker
+const testAnonymousKey = "session-123"
+
+// errorAnonymousIDStore is a store that always fails
+type errorAnonymousIDStore struct{}
+
+// Delete will always fail
+func (e *errorAnonymousIDStore) Delete(_ context.Context, _ string) error {
+	return errors.New("store error")
+}
+
+// Get will always fail
+func (e *errorAnonymousIDStore) Get(_ context.Context, _ string) (string, error) {
+	return "", errors.New("store error")
+}
+
+// Set will always fail
+func (e *errorAnonymousIDStore) Set(_ context.Context, _ string, _ string) error {
+	return errors.New("store error")
+}
+
+// TestNewAnonymousID will test the method NewAnonymousID()
+func TestNewAnonymousID(t *testing.T) {
+	t.Parallel()
+
+	id, err := NewAnonymousID()
+	assert.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`), id)
+
+	var another string
+	another, err = NewAnonymousID()
+	assert.NoError(t, err)
+	assert.NotEqual(t, id, another)
+}
+
+// TestMemoryAnonymousIDStore will test the MemoryAnonymousIDStore
+func TestMemoryAnonymousIDStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := NewMemoryAnonymousIDStore()
+
+	id, err := store.Get(ctx, testAnonymousKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "", id)
+
+	assert.NoError(t, store.Set(ctx, testAnonymousKey, testAnonymousID))
+	id, err = store.Get(ctx, testAnonymousKey)
+	assert.NoError(t, err)
+	assert.Equal(t, testAnonymousID, id)
+
+	assert.NoError(t, store.Delete(ctx, testAnonymousKey))
+	id, err = store.Get(ctx, testAnonymousKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "", id)
+}
+
+// TestAnonymousTracker_AnonymousID will test the method AnonymousID()
+func TestAnonymousTracker_AnonymousID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("generate and persist", func(t *testing.T) {
+		client, err := NewClient(WithTrackingKey(testSiteID, testTrackingAPIKey))
+		assert.NoError(t, err)
+
+		store := NewMemoryAnonymousIDStore()
+		tracker := client.NewAnonymousTracker(store)
+
+		var id string
+		id, err = tracker.AnonymousID(context.Background(), testAnonymousKey)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, id)
+
+		// The same id is returned for the same visitor
+		var again string
+		again, err = tracker.AnonymousID(context.Background(), testAnonymousKey)
+		assert.NoError(t, err)
+		assert.Equal(t, id, again)
+
+		var stored string
+		stored, err = store.Get(context.Background(), testAnonymousKey)
+		assert.NoError(t, err)
+		assert.Equal(t, id, stored)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		client, err := NewClient(WithTrackingKey(testSiteID, testTrackingAPIKey))
+		assert.NoError(t, err)
+
+		_, err = client.NewAnonymousTracker(NewMemoryAnonymousIDStore()).AnonymousID(context.Background(), "")
+		checkParamError(t, err, "key")
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		client, err := NewClient(WithTrackingKey(testSiteID, testTrackingAPIKey))
+		assert.NoError(t, err)
+
+		_, err = client.NewAnonymousTracker(&errorAnonymousIDStore{}).AnonymousID(context.Background(), testAnonymousKey)
+		assert.Error(t, err)
+	})
+}
+
+// TestAnonymousTracker_TrackEvent will test the method TrackEvent()
+func TestAnonymousTracker_TrackEvent(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		store := NewMemoryAnonymousIDStore()
+		assert.NoError(t, store.Set(context.Background(), testAnonymousKey, testAnonymousID))
+
+		mockTrackView(http.StatusOK, "api/v1/events", "", testEventName, testAnonymousID)
+
+		tracker := client.NewAnonymousTracker(store)
+		err = tracker.TrackEvent(context.Background(), testAnonymousKey, testEventName, time.Time{}, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		tracker := client.NewAnonymousTracker(&errorAnonymousIDStore{})
+		err = tracker.TrackEvent(context.Background(), testAnonymousKey, testEventName, time.Time{}, nil)
+		assert.Error(t, err)
+	})
+}
+
+// TestAnonymousTracker_Identify will test the method Identify()
+func TestAnonymousTracker_Identify(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("merge anonymous activity", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		store := NewMemoryAnonymousIDStore()
+		assert.NoError(t, store.Set(context.Background(), testAnonymousKey, testAnonymousID))
+
+		mockIdentifyAnonymous(http.StatusOK, testCustomerID, testAnonymousID)
+
+		tracker := client.NewAnonymousTracker(store)
+		err = tracker.Identify(context.Background(), testAnonymousKey, testCustomerID, nil)
+		assert.NoError(t, err)
+
+		// The anonymous id is removed once identified
+		var id string
+		id, err = store.Get(context.Background(), testAnonymousKey)
+		assert.NoError(t, err)
+		assert.Equal(t, "", id)
+	})
+
+	t.Run("never tracked anonymously", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockIdentifyAnonymous(http.StatusOK, testCustomerID, "")
+
+		tracker := client.NewAnonymousTracker(NewMemoryAnonymousIDStore())
+		err = tracker.Identify(context.Background(), testAnonymousKey, testCustomerID, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("customerIo error keeps the anonymous id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		store := NewMemoryAnonymousIDStore()
+		assert.NoError(t, store.Set(context.Background(), testAnonymousKey, testAnonymousID))
+
+		mockIdentifyAnonymous(http.StatusUnauthorized, testCustomerID, testAnonymousID)
+
+		tracker := client.NewAnonymousTracker(store)
+		err = tracker.Identify(context.Background(), testAnonymousKey, testCustomerID, nil)
+		assert.Error(t, err)
+
+		var id string
+		id, err = store.Get(context.Background(), testAnonymousKey)
+		assert.NoError(t, err)
+		assert.Equal(t, testAnonymousID, id)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		tracker := client.NewAnonymousTracker(NewMemoryAnonymousIDStore())
+		err = tracker.Identify(context.Background(), "", testCustomerID, nil)
+		checkParamError(t, err, "key")
+	})
+}
+
+// ExampleClient_NewAnonymousTracker example using NewAnonymousTracker()
+//
+// See more examples in /examples/
+func ExampleClient_NewAnonymousTracker() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost, testTrackingAPIURL+"api/v1/events",
+		httpmock.NewStringResponder(http.StatusOK, ""))
+	httpmock.RegisterResponder(http.MethodPut, testTrackingAPIURL+"api/v1/customers/"+testCustomerID,
+		httpmock.NewStringResponder(http.StatusOK, ""))
+
+	// Track the visitor before they sign up
+	tracker := client.NewAnonymousTracker(NewMemoryAnonymousIDStore())
+	if err = tracker.TrackEvent(
+		context.Background(), testAnonymousKey, testEventName, time.Now().UTC(), nil,
+	); err != nil {
+		fmt.Printf("error tracking event: %s", err.Error())
+		return
+	}
+
+	// Identify the visitor (adds the anonymous events to the customer)
+	if err = tracker.Identify(context.Background(), testAnonymousKey, testCustomerID, nil); err != nil {
+		fmt.Printf("error identifying customer: %s", err.Error())
+		return
+	}
+	fmt.Printf("customer identified: %s", testCustomerID)
+	// Output:customer identified: 123
+}
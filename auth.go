@@ -1,6 +1,7 @@
 package customerio
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -24,9 +25,17 @@ type RegionInfo struct {
 
 // FindRegion will return the url, data center and environment id
 // See: https://customer.io/docs/api/#tag/trackAuth
-func (c *Client) FindRegion() (region *RegionInfo, err error) {
+func (c *Client) FindRegion() (*RegionInfo, error) {
+	return c.FindRegionWithContext(context.Background())
+}
+
+// FindRegionWithContext is the same as FindRegion() but uses the given context
+// See: https://customer.io/docs/api/#tag/trackAuth
+func (c *Client) FindRegionWithContext(ctx context.Context) (region *RegionInfo, err error) {
 	var resp StandardResponse
 	if resp, err = c.request(
+		ctx,
+		"FindRegion",
 		http.MethodGet,
 		fmt.Sprintf("%s/api/v1/accounts/region", c.options.trackURL),
 		nil,
@@ -40,7 +49,15 @@ func (c *Client) FindRegion() (region *RegionInfo, err error) {
 // TestAuth will test your current Tracking API credentials
 // See: https://customer.io/docs/api/#tag/trackAuth
 func (c *Client) TestAuth() error {
+	return c.TestAuthWithContext(context.Background())
+}
+
+// TestAuthWithContext is the same as TestAuth() but uses the given context
+// See: https://customer.io/docs/api/#tag/trackAuth
+func (c *Client) TestAuthWithContext(ctx context.Context) error {
 	_, err := c.request(
+		ctx,
+		"TestAuth",
 		http.MethodGet,
 		fmt.Sprintf("%s/auth", c.options.trackURL),
 		nil,
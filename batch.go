@@ -0,0 +1,389 @@
+package customerio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// BatchAction is the action to perform on an entity in a batch
+type BatchAction string
+
+// Allowed batch actions
+const (
+	BatchActionAddDevice           BatchAction = "add_device"
+	BatchActionAddRelationships    BatchAction = "add_relationships"
+	BatchActionDelete              BatchAction = "delete"
+	BatchActionDeleteDevice        BatchAction = "delete_device"
+	BatchActionDeleteRelationships BatchAction = "delete_relationships"
+	BatchActionEvent               BatchAction = "event"
+	BatchActionIdentify            BatchAction = "identify"
+	BatchActionSuppress            BatchAction = "suppress"
+	BatchActionUnsuppress          BatchAction = "unsuppress"
+)
+
+// Entity types and object identifiers used in batch operations
+const (
+	batchEntityTypeObject       = "object"
+	batchEntityTypePerson       = "person"
+	batchIdentifierObjectID     = "object_id"
+	batchIdentifierObjectTypeID = "object_type_id"
+)
+
+// BatchOperation is a single entity operation sent to the Track API v2
+// See: https://customer.io/docs/api/track/#operation/batch
+type BatchOperation struct {
+	Action        BatchAction            `json:"action"`
+	Attributes    map[string]interface{} `json:"attributes,omitempty"`
+	Device        *BatchDevice           `json:"device,omitempty"`
+	Identifiers   map[string]string      `json:"identifiers"`
+	Name          string                 `json:"name,omitempty"`
+	Relationships []Relationship         `json:"cio_relationships,omitempty"`
+	Timestamp     int64                  `json:"timestamp,omitempty"`
+	Type          string                 `json:"type"`
+}
+
+// BatchDevice is the device model used by the Track API v2
+type BatchDevice struct {
+	LastUsed int64          `json:"last_used,omitempty"`
+	Platform DevicePlatform `json:"platform,omitempty"`
+	Token    string         `json:"token"`
+}
+
+// BatchItemError is a failed operation from a batch
+type BatchItemError struct {
+	Err       error           // Err is the reason the operation failed
+	Index     int             // Index is the position of the operation in the batch (order it was added)
+	Operation *BatchOperation // Operation is the original operation
+}
+
+// Error will display the error message for the failed operation
+func (b *BatchItemError) Error() string {
+	return fmt.Sprintf("batch operation %d (%s): %s", b.Index, b.Operation.Action, b.Err.Error())
+}
+
+// Unwrap will return the underlying error
+func (b *BatchItemError) Unwrap() error {
+	return b.Err
+}
+
+// BatchResult is the report returned after sending a batch
+type BatchResult struct {
+	Failed    []*BatchItemError // Failed are the operations that were rejected or not sent
+	Requests  int               // Requests is the number of HTTP requests made
+	Succeeded int               // Succeeded is the number of operations accepted
+}
+
+// batchResponse is the error response from the Track API v2 (status 207 or 400)
+type batchResponse struct {
+	Errors []struct {
+		BatchIndex int    `json:"batch_index"`
+		Field      string `json:"field"`
+		Message    string `json:"message"`
+		Reason     string `json:"reason"`
+	} `json:"errors"`
+}
+
+// Errors for operations that are never accepted (retrying will not help)
+var (
+	ErrBatchOperationRejected = errors.New("batch operation rejected")
+	ErrBatchOperationTooLarge = fmt.Errorf("batch operation size limited to %d bytes", maxBatchOperationSize)
+)
+
+// Batch accumulates operations and sends them using the Track API v2 batch endpoint
+// Batches larger than the payload size limit are automatically split into multiple requests
+//
+// A Batch is not safe for concurrent use
+type Batch struct {
+	client       *Client
+	maxBatchSize int
+	operations   []*BatchOperation
+}
+
+// NewBatch will start a new batch of operations
+// See: https://customer.io/docs/api/track/#operation/batch
+func (c *Client) NewBatch() *Batch {
+	return &Batch{
+		client:       c,
+		maxBatchSize: maxBatchSize,
+	}
+}
+
+// Len will return the number of operations in the batch
+func (b *Batch) Len() int {
+	return len(b.operations)
+}
+
+// Add will add a raw operation to the batch
+func (b *Batch) Add(operation *BatchOperation) error {
+	if err := validateBatchOperation(operation); err != nil {
+		return err
+	}
+	b.operations = append(b.operations, operation)
+	return nil
+}
+
+// validateBatchOperation will check the required fields of an operation
+func validateBatchOperation(operation *BatchOperation) error {
+	if operation == nil {
+		return ParamError{Param: "operation"}
+	} else if operation.Action == "" {
+		return ParamError{Param: "action"}
+	} else if operation.Type == "" {
+		return ParamError{Param: "type"}
+	} else if len(operation.Identifiers) == 0 {
+		return ParamError{Param: "identifiers"}
+	}
+	return nil
+}
+
+// Identify will add/update a person and set their attributes
+// Identifiers are one of: id, email or cio_id (IE: {"id": "123"})
+func (b *Batch) Identify(identifiers map[string]string, attributes map[string]interface{}) error {
+	return b.Add(&BatchOperation{
+		Action:      BatchActionIdentify,
+		Attributes:  attributes,
+		Identifiers: identifiers,
+		Type:        batchEntityTypePerson,
+	})
+}
+
+// Track will add an event for a person
+// Use "timestamp" to send events in the past. If not set, it will use Now().UTC()
+func (b *Batch) Track(identifiers map[string]string, eventName string, timestamp time.Time,
+	data map[string]interface{}) error {
+	if eventName == "" {
+		return ParamError{Param: "eventName"}
+	}
+	if timestamp.IsZero() {
+		timestamp = time.Now().UTC()
+	}
+	return b.Add(&BatchOperation{
+		Action:      BatchActionEvent,
+		Attributes:  data,
+		Identifiers: identifiers,
+		Name:        eventName,
+		Timestamp:   timestamp.Unix(),
+		Type:        batchEntityTypePerson,
+	})
+}
+
+// Delete will remove a person
+func (b *Batch) Delete(identifiers map[string]string) error {
+	return b.Add(&BatchOperation{
+		Action:      BatchActionDelete,
+		Identifiers: identifiers,
+		Type:        batchEntityTypePerson,
+	})
+}
+
+// AddDevice will add/update a person's device
+func (b *Batch) AddDevice(identifiers map[string]string, device *Device) error {
+	if device == nil {
+		return ParamError{Param: "device"}
+	} else if device.ID == "" {
+		return ParamError{Param: "deviceID"}
+	} else if !acceptedPlatforms(device.Platform) {
+		return ParamError{Param: "devicePlatform"}
+	}
+	return b.Add(&BatchOperation{
+		Action: BatchActionAddDevice,
+		Device: &BatchDevice{
+			LastUsed: device.LastUsed,
+			Platform: device.Platform,
+			Token:    device.ID,
+		},
+		Identifiers: identifiers,
+		Type:        batchEntityTypePerson,
+	})
+}
+
+// RemoveDevice will remove a person's device
+func (b *Batch) RemoveDevice(identifiers map[string]string, deviceID string) error {
+	if deviceID == "" {
+		return ParamError{Param: "deviceID"}
+	}
+	return b.Add(&BatchOperation{
+		Action:      BatchActionDeleteDevice,
+		Device:      &BatchDevice{Token: deviceID},
+		Identifiers: identifiers,
+		Type:        batchEntityTypePerson,
+	})
+}
+
+// Suppress will suppress a person (deletes the person and prevents re-adding them)
+func (b *Batch) Suppress(identifiers map[string]string) error {
+	return b.Add(&BatchOperation{
+		Action:      BatchActionSuppress,
+		Identifiers: identifiers,
+		Type:        batchEntityTypePerson,
+	})
+}
+
+// Unsuppress will allow a previously suppressed person to be added again
+func (b *Batch) Unsuppress(identifiers map[string]string) error {
+	return b.Add(&BatchOperation{
+		Action:      BatchActionUnsuppress,
+		Identifiers: identifiers,
+		Type:        batchEntityTypePerson,
+	})
+}
+
+// IdentifyObject will create/update an object (IE: a company or account) and set its attributes
+func (b *Batch) IdentifyObject(objectTypeID, objectID string, attributes map[string]interface{}) error {
+	object := NewObjectIdentifier(objectTypeID, objectID)
+	if err := object.validate(); err != nil {
+		return err
+	}
+	return b.Add(&BatchOperation{
+		Action:      BatchActionIdentify,
+		Attributes:  attributes,
+		Identifiers: object.Map(),
+		Type:        batchEntityTypeObject,
+	})
+}
+
+// DeleteObject will remove an object
+func (b *Batch) DeleteObject(objectTypeID, objectID string) error {
+	object := NewObjectIdentifier(objectTypeID, objectID)
+	if err := object.validate(); err != nil {
+		return err
+	}
+	return b.Add(&BatchOperation{
+		Action:      BatchActionDelete,
+		Identifiers: object.Map(),
+		Type:        batchEntityTypeObject,
+	})
+}
+
+// AddObjectRelationships will relate people to an object (with optional relationship attributes)
+func (b *Batch) AddObjectRelationships(object ObjectIdentifier, relationships ...Relationship) error {
+	op, err := objectRelationshipsOperation(BatchActionAddRelationships, object, relationships)
+	if err != nil {
+		return err
+	}
+	return b.Add(op)
+}
+
+// RemoveObjectRelationships will remove the relationships between people and an object
+func (b *Batch) RemoveObjectRelationships(object ObjectIdentifier, relationships ...Relationship) error {
+	op, err := objectRelationshipsOperation(BatchActionDeleteRelationships, object, relationships)
+	if err != nil {
+		return err
+	}
+	return b.Add(op)
+}
+
+// Send will send all the operations in the batch and then reset the batch
+// See: https://customer.io/docs/api/track/#operation/batch
+func (b *Batch) Send() (*BatchResult, error) {
+	return b.SendWithContext(context.Background())
+}
+
+// SendWithContext is the same as Send() but uses the given context
+//
+// Operations rejected by the API are returned in BatchResult.Failed, mapped back to
+// their original position in the batch. The returned error is only set if an entire
+// request failed (IE: bad credentials or the context was canceled)
+func (b *Batch) SendWithContext(ctx context.Context) (*BatchResult, error) {
+	operations := b.operations
+	b.operations = nil
+
+	result := new(BatchResult)
+
+	// Encode each operation and split into chunks under the size limit
+	var chunks [][]int
+	var current []int
+	var currentSize int
+	encoded := make([]json.RawMessage, len(operations))
+	for i, op := range operations {
+		j, err := json.Marshal(op)
+		if err != nil {
+			result.Failed = append(result.Failed, &BatchItemError{Err: err, Index: i, Operation: op})
+			continue
+		} else if len(j) > maxBatchOperationSize {
+			result.Failed = append(result.Failed, &BatchItemError{
+				Err: ErrBatchOperationTooLarge, Index: i, Operation: op,
+			})
+			continue
+		}
+		encoded[i] = j
+
+		// Account for the separating comma and the {"batch":[]} envelope
+		if len(current) > 0 && currentSize+len(j)+1 > b.maxBatchSize-batchEnvelopeSize {
+			chunks = append(chunks, current)
+			current, currentSize = nil, 0
+		}
+		current = append(current, i)
+		currentSize += len(j) + 1
+	}
+	if len(current) > 0 {
+		chunks = append(chunks, current)
+	}
+
+	// Send each chunk
+	var firstErr error
+	for _, chunk := range chunks {
+		items := make([]json.RawMessage, 0, len(chunk))
+		for _, i := range chunk {
+			items = append(items, encoded[i])
+		}
+
+		result.Requests++
+		_, err := b.client.request(
+			ctx,
+			"Batch.Send",
+			http.MethodPost,
+			fmt.Sprintf("%s/api/v2/batch", b.client.options.trackURL),
+			map[string]interface{}{
+				"batch": items,
+			},
+		)
+		if err == nil {
+			result.Succeeded += len(chunk)
+			continue
+		}
+
+		// Partial failure: map each error back to the original operation
+		var apiErr *APIError
+		var resp batchResponse
+		if errors.As(err, &apiErr) && (apiErr.status == http.StatusMultiStatus ||
+			apiErr.status == http.StatusBadRequest) &&
+			json.Unmarshal(apiErr.body, &resp) == nil && len(resp.Errors) > 0 {
+			failed := make(map[int]bool, len(resp.Errors))
+			for _, e := range resp.Errors {
+				if e.BatchIndex < 0 || e.BatchIndex >= len(chunk) {
+					continue
+				}
+				i := chunk[e.BatchIndex]
+				failed[e.BatchIndex] = true
+				result.Failed = append(result.Failed, &BatchItemError{
+					Err:       fmt.Errorf("%w: %s: %s %s", ErrBatchOperationRejected, e.Reason, e.Field, e.Message),
+					Index:     i,
+					Operation: operations[i],
+				})
+			}
+			result.Succeeded += len(chunk) - len(failed)
+			continue
+		}
+
+		// The entire request failed
+		if firstErr == nil {
+			firstErr = err
+		}
+		for _, i := range chunk {
+			result.Failed = append(result.Failed, &BatchItemError{Err: err, Index: i, Operation: operations[i]})
+		}
+	}
+
+	// Keep the report in the same order as the batch
+	sort.Slice(result.Failed, func(i, j int) bool {
+		return result.Failed[i].Index < result.Failed[j].Index
+	})
+
+	return result, firstErr
+}
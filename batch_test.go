@@ -0,0 +1,219 @@
+package customerio
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestClient_NewBatch will test the method NewBatch()
+func TestClient_NewBatch(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockBatch(http.StatusOK, "")
+
+		batch := client.NewBatch()
+		assert.NoError(t, batch.Identify(map[string]string{"id": testCustomerID}, map[string]interface{}{
+			"email": testCustomerEmail,
+		}))
+		assert.NoError(t, batch.Track(map[string]string{"id": testCustomerID}, testEventName, time.Time{}, nil))
+		assert.NoError(t, batch.AddDevice(map[string]string{"id": testCustomerID}, &Device{
+			ID:       testDeviceID,
+			Platform: PlatformIOs,
+		}))
+		assert.NoError(t, batch.RemoveDevice(map[string]string{"id": testCustomerID}, testDeviceID))
+		assert.NoError(t, batch.Suppress(map[string]string{"email": testCustomerEmail}))
+		assert.NoError(t, batch.Unsuppress(map[string]string{"email": testCustomerEmail}))
+		assert.NoError(t, batch.IdentifyObject("1", "acme", map[string]interface{}{"name": "Acme"}))
+		assert.NoError(t, batch.AddObjectRelationships(
+			NewObjectIdentifier("1", "acme"), NewRelationship(testCustomerID, map[string]interface{}{"role": "admin"}),
+		))
+		assert.NoError(t, batch.RemoveObjectRelationships(
+			NewObjectIdentifier("1", "acme"), NewRelationship(testCustomerEmail, nil),
+		))
+		assert.NoError(t, batch.DeleteObject("1", "acme"))
+		assert.NoError(t, batch.Delete(map[string]string{"id": testCustomerID}))
+		assert.Equal(t, 11, batch.Len())
+
+		var result *BatchResult
+		result, err = batch.Send()
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, 11, result.Succeeded)
+		assert.Equal(t, 1, result.Requests)
+		assert.Empty(t, result.Failed)
+		assert.Equal(t, 0, batch.Len())
+	})
+
+	t.Run("split into multiple requests", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockBatch(http.StatusOK, "")
+
+		batch := client.NewBatch()
+		batch.maxBatchSize = 256
+		for i := 0; i < 10; i++ {
+			assert.NoError(t, batch.Identify(
+				map[string]string{"id": fmt.Sprintf("%d", i)},
+				map[string]interface{}{"email": testCustomerEmail},
+			))
+		}
+
+		var result *BatchResult
+		result, err = batch.Send()
+		assert.NoError(t, err)
+		assert.Equal(t, 10, result.Succeeded)
+		assert.Greater(t, result.Requests, 1)
+		assert.Equal(t, result.Requests, httpmock.GetTotalCallCount())
+	})
+
+	t.Run("partial failure", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockBatch(
+			http.StatusMultiStatus,
+			`{"errors":[{"batch_index":1,"reason":"invalid","field":"identifiers","message":"missing id"}]}`,
+		)
+
+		batch := client.NewBatch()
+		assert.NoError(t, batch.Identify(map[string]string{"id": testCustomerID}, nil))
+		assert.NoError(t, batch.Identify(map[string]string{"id": "bad"}, nil))
+		assert.NoError(t, batch.Delete(map[string]string{"id": testCustomerID}))
+
+		var result *BatchResult
+		result, err = batch.Send()
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Succeeded)
+		assert.Len(t, result.Failed, 1)
+		assert.Equal(t, 1, result.Failed[0].Index)
+		assert.Equal(t, "bad", result.Failed[0].Operation.Identifiers["id"])
+		assert.Contains(t, result.Failed[0].Error(), "missing id")
+	})
+
+	t.Run("operation too large", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockBatch(http.StatusOK, "")
+
+		batch := client.NewBatch()
+		assert.NoError(t, batch.Identify(map[string]string{"id": testCustomerID}, map[string]interface{}{
+			"notes": strings.Repeat("a", maxBatchOperationSize),
+		}))
+		assert.NoError(t, batch.Delete(map[string]string{"id": testCustomerID}))
+
+		var result *BatchResult
+		result, err = batch.Send()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Succeeded)
+		assert.Len(t, result.Failed, 1)
+		assert.True(t, errors.Is(result.Failed[0], ErrBatchOperationTooLarge))
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockBatch(http.StatusUnauthorized, `{"meta":{"error":"unauthorized"}}`)
+
+		batch := client.NewBatch()
+		assert.NoError(t, batch.Delete(map[string]string{"id": testCustomerID}))
+		assert.NoError(t, batch.Delete(map[string]string{"id": testCustomerID + "456"}))
+
+		var result *BatchResult
+		result, err = batch.Send()
+		assert.Error(t, err)
+		assert.Equal(t, 0, result.Succeeded)
+		assert.Len(t, result.Failed, 2)
+	})
+
+	t.Run("invalid operations", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		batch := client.NewBatch()
+		checkParamError(t, batch.Add(nil), "operation")
+		checkParamError(t, batch.Identify(nil, nil), "identifiers")
+		checkParamError(t, batch.Track(map[string]string{"id": testCustomerID}, "", time.Time{}, nil), "eventName")
+		checkParamError(t, batch.AddDevice(map[string]string{"id": testCustomerID}, nil), "device")
+		checkParamError(t, batch.AddDevice(map[string]string{"id": testCustomerID}, &Device{
+			ID: testDeviceID, Platform: "windows",
+		}), "devicePlatform")
+		checkParamError(t, batch.RemoveDevice(map[string]string{"id": testCustomerID}, ""), "deviceID")
+		checkParamError(t, batch.IdentifyObject("", "acme", nil), "objectTypeID")
+		checkParamError(t, batch.DeleteObject("1", ""), "objectID")
+		checkParamError(t, batch.AddObjectRelationships(NewObjectIdentifier("1", "acme")), "relationships")
+		checkParamError(t, batch.RemoveObjectRelationships(
+			NewObjectIdentifier("", "acme"), NewRelationship(testCustomerID, nil),
+		), "objectTypeID")
+		assert.Equal(t, 0, batch.Len())
+	})
+}
+
+// ExampleClient_NewBatch example using NewBatch()
+//
+// See more examples in /examples/
+func ExampleClient_NewBatch() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockBatch(http.StatusOK, "")
+
+	// Start a batch
+	batch := client.NewBatch()
+	_ = batch.Identify(map[string]string{"id": testCustomerID}, map[string]interface{}{"plan": "basic"})
+	_ = batch.Track(map[string]string{"id": testCustomerID}, testEventName, time.Now().UTC(), nil)
+
+	// Send the batch
+	var result *BatchResult
+	if result, err = batch.Send(); err != nil {
+		fmt.Printf("error sending batch: %s", err.Error())
+		return
+	}
+	fmt.Printf("batch sent: %d operations", result.Succeeded)
+	// Output:batch sent: 2 operations
+}
+
+// BenchmarkBatch_Send benchmarks the method Send()
+func BenchmarkBatch_Send(b *testing.B) {
+	client, _ := newTestClient()
+	mockBatch(http.StatusOK, "")
+	for i := 0; i < b.N; i++ {
+		batch := client.NewBatch()
+		_ = batch.Identify(map[string]string{"id": testCustomerID}, map[string]interface{}{"plan": "basic"})
+		_, _ = batch.Send()
+	}
+}
+
+// mockBatch is used for mocking the response
+func mockBatch(statusCode int, body string) {
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%sapi/v2/batch", testTrackingAPIURL),
+		httpmock.NewStringResponder(
+			statusCode, body,
+		),
+	)
+}
@@ -0,0 +1,189 @@
+package customerio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// BroadcastTrigger is the request to trigger an API triggered broadcast
+// Set exactly one audience: Recipients, IDs, Emails, PerUserData or DataFileURL
+// See: https://customer.io/docs/api/app/#operation/triggerBroadcast
+type BroadcastTrigger struct {
+	Data               map[string]interface{} `json:"data,omitempty"`                 // Available in the liquid
+	DataFileURL        string                 `json:"data_file_url,omitempty"`        // JSON lines file of recipients
+	EmailAddDuplicates bool                   `json:"email_add_duplicates,omitempty"` // Send to all matching profiles
+	EmailIgnoreMissing bool                   `json:"email_ignore_missing,omitempty"` // Skip emails without a profile
+	Emails             []string               `json:"emails,omitempty"`               // Emails of the recipients
+	IDIgnoreMissing    bool                   `json:"id_ignore_missing,omitempty"`    // Skip ids without a profile
+	IDs                []string               `json:"ids,omitempty"`                  // IDs of the recipients
+	PerUserData        []*BroadcastRecipient  `json:"per_user_data,omitempty"`        // Each recipient with their data
+	Recipients         *CustomerFilter        `json:"recipients,omitempty"`           // Filter (IE: a segment)
+}
+
+// BroadcastRecipient is a single recipient (and their data) of a broadcast
+// Set either the ID or the Email
+type BroadcastRecipient struct {
+	Data  map[string]interface{} `json:"data,omitempty"`
+	Email string                 `json:"email,omitempty"`
+	ID    string                 `json:"id,omitempty"`
+}
+
+// BroadcastTriggerStatus is the status of a broadcast trigger
+type BroadcastTriggerStatus struct {
+	CampaignID  int64 `json:"campaign_id"`
+	CreatedAt   int64 `json:"created_at"`
+	ID          int64 `json:"id"`
+	Processed   bool  `json:"processed"`
+	ProcessedAt int64 `json:"processed_at"`
+}
+
+// BroadcastTriggerError is an error for a single recipient of a broadcast trigger
+type BroadcastTriggerError struct {
+	BatchIndex int64  `json:"batch_index"`
+	Field      string `json:"field"`
+	Message    string `json:"message"`
+	Reason     string `json:"reason"`
+}
+
+// audiences will return the number of audiences set on the trigger
+func (b *BroadcastTrigger) audiences() (count int) {
+	for _, set := range []bool{
+		b.Recipients != nil,
+		len(b.IDs) > 0,
+		len(b.Emails) > 0,
+		len(b.PerUserData) > 0,
+		b.DataFileURL != "",
+	} {
+		if set {
+			count++
+		}
+	}
+	return
+}
+
+// TriggerBroadcast will trigger an API triggered broadcast and return the trigger id
+// See: https://customer.io/docs/api/app/#operation/triggerBroadcast
+func (c *Client) TriggerBroadcast(broadcastID int64, trigger *BroadcastTrigger) (int64, error) {
+	return c.TriggerBroadcastWithContext(context.Background(), broadcastID, trigger)
+}
+
+// TriggerBroadcastWithContext is the same as TriggerBroadcast() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/triggerBroadcast
+func (c *Client) TriggerBroadcastWithContext(ctx context.Context, broadcastID int64,
+	trigger *BroadcastTrigger) (int64, error) {
+	if broadcastID <= 0 {
+		return 0, ParamError{Param: "broadcastID"}
+	} else if trigger == nil {
+		return 0, ParamError{Param: "trigger"}
+	} else if trigger.audiences() != 1 {
+		return 0, ParamError{Param: "audience"}
+	}
+	for _, recipient := range trigger.PerUserData {
+		if recipient == nil || (recipient.ID == "" && recipient.Email == "") {
+			return 0, ParamError{Param: "perUserData"}
+		}
+	}
+
+	response, err := c.request(
+		ctx,
+		"TriggerBroadcast",
+		http.MethodPost,
+		fmt.Sprintf("%s/v1/campaigns/%d/triggers", c.options.apiURL, broadcastID),
+		trigger,
+	)
+	if err != nil {
+		return 0, err
+	}
+	var r struct {
+		ID int64 `json:"id"`
+	}
+	if err = json.Unmarshal(response.Body, &r); err != nil {
+		return 0, err
+	}
+	return r.ID, nil
+}
+
+// GetBroadcastTriggerStatus will return the status of a broadcast trigger
+// See: https://customer.io/docs/api/app/#operation/getBroadcastTriggerStatus
+func (c *Client) GetBroadcastTriggerStatus(broadcastID, triggerID int64) (*BroadcastTriggerStatus, error) {
+	return c.GetBroadcastTriggerStatusWithContext(context.Background(), broadcastID, triggerID)
+}
+
+// GetBroadcastTriggerStatusWithContext is the same as GetBroadcastTriggerStatus() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getBroadcastTriggerStatus
+func (c *Client) GetBroadcastTriggerStatusWithContext(ctx context.Context,
+	broadcastID, triggerID int64) (*BroadcastTriggerStatus, error) {
+	if broadcastID <= 0 {
+		return nil, ParamError{Param: "broadcastID"}
+	} else if triggerID <= 0 {
+		return nil, ParamError{Param: "triggerID"}
+	}
+	response, err := c.request(
+		ctx,
+		"GetBroadcastTriggerStatus",
+		http.MethodGet,
+		fmt.Sprintf("%s/v1/campaigns/%d/triggers/%d", c.options.apiURL, broadcastID, triggerID),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var r struct {
+		Trigger *BroadcastTriggerStatus `json:"trigger"`
+	}
+	if err = json.Unmarshal(response.Body, &r); err != nil {
+		return nil, err
+	}
+	return r.Trigger, nil
+}
+
+// ListBroadcastTriggerErrors will return a page of the errors for a broadcast trigger
+// and the cursor for the next page
+// See: https://customer.io/docs/api/app/#operation/getBroadcastTriggerErrors
+func (c *Client) ListBroadcastTriggerErrors(broadcastID, triggerID int64,
+	opts *ListOptions) ([]*BroadcastTriggerError, string, error) {
+	return c.ListBroadcastTriggerErrorsWithContext(context.Background(), broadcastID, triggerID, opts)
+}
+
+// ListBroadcastTriggerErrorsWithContext is the same as ListBroadcastTriggerErrors() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getBroadcastTriggerErrors
+func (c *Client) ListBroadcastTriggerErrorsWithContext(ctx context.Context, broadcastID, triggerID int64,
+	opts *ListOptions) ([]*BroadcastTriggerError, string, error) {
+	if broadcastID <= 0 {
+		return nil, "", ParamError{Param: "broadcastID"}
+	} else if triggerID <= 0 {
+		return nil, "", ParamError{Param: "triggerID"}
+	}
+	response, err := c.request(
+		ctx,
+		"ListBroadcastTriggerErrors",
+		http.MethodGet,
+		withQuery(
+			fmt.Sprintf("%s/v1/campaigns/%d/triggers/%d/errors", c.options.apiURL, broadcastID, triggerID),
+			opts.values(),
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	var r struct {
+		Errors []*BroadcastTriggerError `json:"errors"`
+		Next   string                   `json:"next"`
+	}
+	if err = json.Unmarshal(response.Body, &r); err != nil {
+		return nil, "", err
+	}
+	return r.Errors, r.Next, nil
+}
+
+// BroadcastTriggerErrorsPaginator will return a paginator over all the errors for a broadcast trigger
+// See: https://customer.io/docs/api/app/#operation/getBroadcastTriggerErrors
+func (c *Client) BroadcastTriggerErrorsPaginator(broadcastID, triggerID int64,
+	opts ...PaginatorOps) *Paginator[*BroadcastTriggerError] {
+	return NewPaginator(func(ctx context.Context, list *ListOptions) ([]*BroadcastTriggerError, string, error) {
+		return c.ListBroadcastTriggerErrorsWithContext(ctx, broadcastID, triggerID, list)
+	}, opts...)
+}
@@ -0,0 +1,277 @@
+package customerio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testBroadcastID int64 = 12
+	testTriggerID   int64 = 3
+)
+
+// TestClient_TriggerBroadcast will test the method TriggerBroadcast()
+func TestClient_TriggerBroadcast(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	audiences := map[string]*BroadcastTrigger{
+		"segment": {Recipients: &CustomerFilter{Segment: &SegmentFilter{ID: testSegmentID}}},
+		"ids":     {IDs: []string{testCustomerID}, IDIgnoreMissing: true},
+		"emails":  {Emails: []string{testCustomerEmail}, EmailIgnoreMissing: true},
+		"per user data": {PerUserData: []*BroadcastRecipient{
+			{ID: testCustomerID, Data: map[string]interface{}{"plan": "basic"}},
+			{Email: testCustomerEmail},
+		}},
+		"data file url": {DataFileURL: "https://example.com/recipients.json"},
+	}
+	for name, trigger := range audiences {
+		t.Run("successful response ("+name+")", func(t *testing.T) {
+			client, err := newTestClient()
+			assert.NoError(t, err)
+			assert.NotNil(t, client)
+
+			mockTriggerBroadcast(http.StatusOK)
+
+			trigger.Data = map[string]interface{}{"headline": "Sale"}
+
+			var triggerID int64
+			triggerID, err = client.TriggerBroadcast(testBroadcastID, trigger)
+			assert.NoError(t, err)
+			assert.Equal(t, testTriggerID, triggerID)
+		})
+	}
+
+	t.Run("missing broadcast id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.TriggerBroadcast(0, &BroadcastTrigger{IDs: []string{testCustomerID}})
+		checkParamError(t, err, "broadcastID")
+	})
+
+	t.Run("missing trigger", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.TriggerBroadcast(testBroadcastID, nil)
+		checkParamError(t, err, "trigger")
+	})
+
+	t.Run("missing audience", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.TriggerBroadcast(testBroadcastID, &BroadcastTrigger{
+			Data: map[string]interface{}{"headline": "Sale"},
+		})
+		checkParamError(t, err, "audience")
+	})
+
+	t.Run("multiple audiences", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.TriggerBroadcast(testBroadcastID, &BroadcastTrigger{
+			Emails: []string{testCustomerEmail},
+			IDs:    []string{testCustomerID},
+		})
+		checkParamError(t, err, "audience")
+	})
+
+	t.Run("invalid per user data", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.TriggerBroadcast(testBroadcastID, &BroadcastTrigger{
+			PerUserData: []*BroadcastRecipient{{Data: map[string]interface{}{"plan": "basic"}}},
+		})
+		checkParamError(t, err, "perUserData")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockTriggerBroadcast(http.StatusUnauthorized)
+
+		_, err = client.TriggerBroadcast(testBroadcastID, &BroadcastTrigger{IDs: []string{testCustomerID}})
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_GetBroadcastTriggerStatus will test the method GetBroadcastTriggerStatus()
+func TestClient_GetBroadcastTriggerStatus(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockBroadcastTrigger(http.StatusOK, "", "",
+			`{"trigger":{"id":3,"campaign_id":12,"created_at":1600000000,"processed":true,"processed_at":1600000060}}`,
+		)
+
+		var status *BroadcastTriggerStatus
+		status, err = client.GetBroadcastTriggerStatus(testBroadcastID, testTriggerID)
+		assert.NoError(t, err)
+		assert.NotNil(t, status)
+		assert.Equal(t, testTriggerID, status.ID)
+		assert.Equal(t, testBroadcastID, status.CampaignID)
+		assert.True(t, status.Processed)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetBroadcastTriggerStatus(0, testTriggerID)
+		checkParamError(t, err, "broadcastID")
+
+		_, err = client.GetBroadcastTriggerStatus(testBroadcastID, 0)
+		checkParamError(t, err, "triggerID")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockBroadcastTrigger(http.StatusNotFound, "", "", "")
+
+		_, err = client.GetBroadcastTriggerStatus(testBroadcastID, testTriggerID)
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_ListBroadcastTriggerErrors will test the method ListBroadcastTriggerErrors()
+func TestClient_ListBroadcastTriggerErrors(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockBroadcastTrigger(http.StatusOK, "/errors", "limit=1",
+			`{"errors":[{"batch_index":0,"reason":"not found","field":"id","message":"customer not found"}],"next":"abc"}`,
+		)
+
+		var errs []*BroadcastTriggerError
+		var next string
+		errs, next, err = client.ListBroadcastTriggerErrors(testBroadcastID, testTriggerID, &ListOptions{Limit: 1})
+		assert.NoError(t, err)
+		assert.Len(t, errs, 1)
+		assert.Equal(t, "customer not found", errs[0].Message)
+		assert.Equal(t, "abc", next)
+	})
+
+	t.Run("paginator", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockBroadcastTrigger(http.StatusOK, "/errors", "",
+			`{"errors":[{"batch_index":0,"reason":"not found"}],"next":"abc"}`,
+		)
+		httpmock.RegisterResponder(http.MethodGet,
+			fmt.Sprintf("%sv1/campaigns/%d/triggers/%d/errors?start=abc", testAppAPIURL, testBroadcastID, testTriggerID),
+			httpmock.NewStringResponder(http.StatusOK, `{"errors":[{"batch_index":4,"reason":"not found"}],"next":""}`),
+		)
+
+		var errs []*BroadcastTriggerError
+		errs, err = client.BroadcastTriggerErrorsPaginator(testBroadcastID, testTriggerID).All(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, errs, 2)
+		assert.Equal(t, int64(4), errs[1].BatchIndex)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, _, err = client.ListBroadcastTriggerErrors(testBroadcastID, 0, nil)
+		checkParamError(t, err, "triggerID")
+	})
+}
+
+// ExampleClient_TriggerBroadcast example using TriggerBroadcast()
+//
+// See more examples in /examples/
+func ExampleClient_TriggerBroadcast() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockTriggerBroadcast(http.StatusOK)
+
+	// Trigger the broadcast for a segment
+	var triggerID int64
+	if triggerID, err = client.TriggerBroadcast(testBroadcastID, &BroadcastTrigger{
+		Data:       map[string]interface{}{"headline": "Sale"},
+		Recipients: &CustomerFilter{Segment: &SegmentFilter{ID: testSegmentID}},
+	}); err != nil {
+		fmt.Printf("error triggering broadcast: %s", err.Error())
+		return
+	}
+	fmt.Printf("broadcast triggered: %d", triggerID)
+	// Output:broadcast triggered: 3
+}
+
+// BenchmarkClient_TriggerBroadcast benchmarks the method TriggerBroadcast()
+func BenchmarkClient_TriggerBroadcast(b *testing.B) {
+	client, _ := newTestClient()
+	mockTriggerBroadcast(http.StatusOK)
+	trigger := &BroadcastTrigger{IDs: []string{testCustomerID}}
+	for i := 0; i < b.N; i++ {
+		_, _ = client.TriggerBroadcast(testBroadcastID, trigger)
+	}
+}
+
+// mockTriggerBroadcast is used for mocking the response
+// Responds with a 400 if the request body does not have exactly one audience
+func mockTriggerBroadcast(statusCode int) {
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost,
+		fmt.Sprintf("%sv1/campaigns/%d/triggers", testAppAPIURL, testBroadcastID),
+		func(req *http.Request) (*http.Response, error) {
+			var trigger BroadcastTrigger
+			if err := json.NewDecoder(req.Body).Decode(&trigger); err != nil || trigger.audiences() != 1 {
+				return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+			}
+			return httpmock.NewStringResponse(statusCode, fmt.Sprintf(`{"id":%d}`, testTriggerID)), nil
+		},
+	)
+}
+
+// mockBroadcastTrigger is used for mocking the response
+func mockBroadcastTrigger(statusCode int, path, query, body string) {
+	httpmock.Reset()
+	requestURL := fmt.Sprintf("%sv1/campaigns/%d/triggers/%d%s", testAppAPIURL, testBroadcastID, testTriggerID, path)
+	if query != "" {
+		requestURL += "?" + query
+	}
+	httpmock.RegisterResponder(http.MethodGet, requestURL,
+		httpmock.NewStringResponder(
+			statusCode, body,
+		),
+	)
+}
@@ -0,0 +1,234 @@
+package customerio
+
+import (
+	"context"
+	"fmt"
+)
+
+// Campaign is a campaign from the App API
+// See: https://customer.io/docs/api/app/#tag/Campaigns
+type Campaign struct {
+	Actions           []*CampaignActionSummary `json:"actions"`
+	Active            bool                     `json:"active"`
+	Created           int64                    `json:"created"`
+	CreatedBy         string                   `json:"created_by"`
+	DeduplicateID     string                   `json:"deduplicate_id"`
+	FilterSegmentIDs  []int64                  `json:"filter_segment_ids"`
+	FirstStarted      int64                    `json:"first_started"`
+	ID                int64                    `json:"id"`
+	Name              string                   `json:"name"`
+	State             string                   `json:"state"`
+	Tags              []string                 `json:"tags"`
+	TriggerSegmentIDs []int64                  `json:"trigger_segment_ids"`
+	Type              string                   `json:"type"` // Type is the trigger (IE: "segment", "event" or "api")
+	Updated           int64                    `json:"updated"`
+}
+
+// CampaignActionSummary is the id and type of an action in a campaign
+type CampaignActionSummary struct {
+	ID   int64  `json:"id"`
+	Type string `json:"type"`
+}
+
+// Action is a message in a campaign (or a newsletter variant)
+type Action struct {
+	Bcc            string            `json:"bcc"`
+	Body           string            `json:"body"`
+	CampaignID     int64             `json:"campaign_id"`
+	Created        int64             `json:"created"`
+	DeduplicateID  string            `json:"deduplicate_id"`
+	FakeBcc        bool              `json:"fake_bcc"`
+	From           string            `json:"from"`
+	FromID         int64             `json:"from_id"`
+	Headers        map[string]string `json:"headers"`
+	ID             int64             `json:"id"`
+	Language       string            `json:"language"`
+	Layout         string            `json:"layout"`
+	Name           string            `json:"name"`
+	NewsletterID   int64             `json:"newsletter_id"`
+	ParentActionID int64             `json:"parent_action_id"`
+	PreheaderText  string            `json:"preheader_text"`
+	Recipient      string            `json:"recipient"`
+	ReplyTo        string            `json:"reply_to"`
+	ReplyToID      int64             `json:"reply_to_id"`
+	Sending        bool              `json:"sending"`
+	Subject        string            `json:"subject"`
+	Type           string            `json:"type"` // Type is the message type (IE: "email", "push" or "webhook")
+	Updated        int64             `json:"updated"`
+}
+
+// ListCampaigns will return all the campaigns in the workspace
+// See: https://customer.io/docs/api/app/#operation/listCampaigns
+func (c *Client) ListCampaigns() ([]*Campaign, error) {
+	return c.ListCampaignsWithContext(context.Background())
+}
+
+// ListCampaignsWithContext is the same as ListCampaigns() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/listCampaigns
+func (c *Client) ListCampaignsWithContext(ctx context.Context) ([]*Campaign, error) {
+	var r struct {
+		Campaigns []*Campaign `json:"campaigns"`
+	}
+	if err := c.getAppResource(ctx, "ListCampaigns", "/v1/campaigns", nil, &r); err != nil {
+		return nil, err
+	}
+	return r.Campaigns, nil
+}
+
+// GetCampaign will return a campaign
+// See: https://customer.io/docs/api/app/#operation/getCampaign
+func (c *Client) GetCampaign(campaignID int64) (*Campaign, error) {
+	return c.GetCampaignWithContext(context.Background(), campaignID)
+}
+
+// GetCampaignWithContext is the same as GetCampaign() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getCampaign
+func (c *Client) GetCampaignWithContext(ctx context.Context, campaignID int64) (*Campaign, error) {
+	if campaignID <= 0 {
+		return nil, ParamError{Param: "campaignID"}
+	}
+	var r struct {
+		Campaign *Campaign `json:"campaign"`
+	}
+	if err := c.getAppResource(ctx, "GetCampaign", fmt.Sprintf("/v1/campaigns/%d", campaignID), nil, &r); err != nil {
+		return nil, err
+	}
+	return r.Campaign, nil
+}
+
+// GetCampaignMetrics will return the metrics time series for a campaign
+// See: https://customer.io/docs/api/app/#operation/campaignMetrics
+func (c *Client) GetCampaignMetrics(campaignID int64, opts *MetricsOptions) (*MetricSeries, error) {
+	return c.GetCampaignMetricsWithContext(context.Background(), campaignID, opts)
+}
+
+// GetCampaignMetricsWithContext is the same as GetCampaignMetrics() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/campaignMetrics
+func (c *Client) GetCampaignMetricsWithContext(ctx context.Context, campaignID int64,
+	opts *MetricsOptions) (*MetricSeries, error) {
+	if campaignID <= 0 {
+		return nil, ParamError{Param: "campaignID"}
+	}
+	return c.getMetrics(ctx, "GetCampaignMetrics", fmt.Sprintf("/v1/campaigns/%d/metrics", campaignID), opts)
+}
+
+// GetCampaignLinkMetrics will return the metrics time series for each link in a campaign
+// See: https://customer.io/docs/api/app/#operation/campaignLinkMetrics
+func (c *Client) GetCampaignLinkMetrics(campaignID int64, opts *MetricsOptions) ([]*LinkMetrics, error) {
+	return c.GetCampaignLinkMetricsWithContext(context.Background(), campaignID, opts)
+}
+
+// GetCampaignLinkMetricsWithContext is the same as GetCampaignLinkMetrics() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/campaignLinkMetrics
+func (c *Client) GetCampaignLinkMetricsWithContext(ctx context.Context, campaignID int64,
+	opts *MetricsOptions) ([]*LinkMetrics, error) {
+	if campaignID <= 0 {
+		return nil, ParamError{Param: "campaignID"}
+	}
+	return c.getLinkMetrics(ctx, "GetCampaignLinkMetrics", fmt.Sprintf("/v1/campaigns/%d/metrics/links", campaignID), opts)
+}
+
+// ListCampaignActions will return a page of the actions in a campaign and the cursor for the next page
+// See: https://customer.io/docs/api/app/#operation/listCampaignActions
+func (c *Client) ListCampaignActions(campaignID int64, opts *ListOptions) ([]*Action, string, error) {
+	return c.ListCampaignActionsWithContext(context.Background(), campaignID, opts)
+}
+
+// ListCampaignActionsWithContext is the same as ListCampaignActions() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/listCampaignActions
+func (c *Client) ListCampaignActionsWithContext(ctx context.Context, campaignID int64,
+	opts *ListOptions) ([]*Action, string, error) {
+	if campaignID <= 0 {
+		return nil, "", ParamError{Param: "campaignID"}
+	}
+	var r struct {
+		Actions []*Action `json:"actions"`
+		Next    string    `json:"next"`
+	}
+	if err := c.getAppResource(
+		ctx, "ListCampaignActions", fmt.Sprintf("/v1/campaigns/%d/actions", campaignID), opts.values(), &r,
+	); err != nil {
+		return nil, "", err
+	}
+	return r.Actions, r.Next, nil
+}
+
+// CampaignActionsPaginator will return a paginator over all the actions in a campaign
+// See: https://customer.io/docs/api/app/#operation/listCampaignActions
+func (c *Client) CampaignActionsPaginator(campaignID int64, opts ...PaginatorOps) *Paginator[*Action] {
+	return NewPaginator(func(ctx context.Context, list *ListOptions) ([]*Action, string, error) {
+		return c.ListCampaignActionsWithContext(ctx, campaignID, list)
+	}, opts...)
+}
+
+// GetCampaignAction will return an action in a campaign
+// See: https://customer.io/docs/api/app/#operation/getCampaignAction
+func (c *Client) GetCampaignAction(campaignID, actionID int64) (*Action, error) {
+	return c.GetCampaignActionWithContext(context.Background(), campaignID, actionID)
+}
+
+// GetCampaignActionWithContext is the same as GetCampaignAction() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getCampaignAction
+func (c *Client) GetCampaignActionWithContext(ctx context.Context, campaignID, actionID int64) (*Action, error) {
+	if campaignID <= 0 {
+		return nil, ParamError{Param: "campaignID"}
+	} else if actionID <= 0 {
+		return nil, ParamError{Param: "actionID"}
+	}
+	var r struct {
+		Action *Action `json:"action"`
+	}
+	if err := c.getAppResource(
+		ctx, "GetCampaignAction", fmt.Sprintf("/v1/campaigns/%d/actions/%d", campaignID, actionID), nil, &r,
+	); err != nil {
+		return nil, err
+	}
+	return r.Action, nil
+}
+
+// GetCampaignActionMetrics will return the metrics time series for an action in a campaign
+// See: https://customer.io/docs/api/app/#operation/campaignActionMetrics
+func (c *Client) GetCampaignActionMetrics(campaignID, actionID int64, opts *MetricsOptions) (*MetricSeries, error) {
+	return c.GetCampaignActionMetricsWithContext(context.Background(), campaignID, actionID, opts)
+}
+
+// GetCampaignActionMetricsWithContext is the same as GetCampaignActionMetrics() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/campaignActionMetrics
+func (c *Client) GetCampaignActionMetricsWithContext(ctx context.Context, campaignID, actionID int64,
+	opts *MetricsOptions) (*MetricSeries, error) {
+	if campaignID <= 0 {
+		return nil, ParamError{Param: "campaignID"}
+	} else if actionID <= 0 {
+		return nil, ParamError{Param: "actionID"}
+	}
+	return c.getMetrics(
+		ctx,
+		"GetCampaignActionMetrics",
+		fmt.Sprintf("/v1/campaigns/%d/actions/%d/metrics", campaignID, actionID),
+		opts,
+	)
+}
+
+// GetCampaignActionLinkMetrics will return the metrics time series for each link in an action
+// See: https://customer.io/docs/api/app/#operation/campaignActionLinkMetrics
+func (c *Client) GetCampaignActionLinkMetrics(campaignID, actionID int64,
+	opts *MetricsOptions) ([]*LinkMetrics, error) {
+	return c.GetCampaignActionLinkMetricsWithContext(context.Background(), campaignID, actionID, opts)
+}
+
+// GetCampaignActionLinkMetricsWithContext is the same as GetCampaignActionLinkMetrics() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/campaignActionLinkMetrics
+func (c *Client) GetCampaignActionLinkMetricsWithContext(ctx context.Context, campaignID, actionID int64,
+	opts *MetricsOptions) ([]*LinkMetrics, error) {
+	if campaignID <= 0 {
+		return nil, ParamError{Param: "campaignID"}
+	} else if actionID <= 0 {
+		return nil, ParamError{Param: "actionID"}
+	}
+	return c.getLinkMetrics(
+		ctx,
+		"GetCampaignActionLinkMetrics",
+		fmt.Sprintf("/v1/campaigns/%d/actions/%d/metrics/links", campaignID, actionID),
+		opts,
+	)
+}
@@ -0,0 +1,351 @@
+package customerio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testActionID   int64 = 5
+	testCampaignID int64 = 9
+)
+
+// TestClient_ListCampaigns will test the method ListCampaigns()
+func TestClient_ListCampaigns(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK, "v1/campaigns", "",
+			`{"campaigns":[{"id":9,"name":"Onboarding","type":"segment","active":true,`+
+				`"actions":[{"id":5,"type":"email"}],"trigger_segment_ids":[7]}]}`,
+		)
+
+		var campaigns []*Campaign
+		campaigns, err = client.ListCampaigns()
+		assert.NoError(t, err)
+		assert.Len(t, campaigns, 1)
+		assert.Equal(t, testCampaignID, campaigns[0].ID)
+		assert.True(t, campaigns[0].Active)
+		assert.Equal(t, testActionID, campaigns[0].Actions[0].ID)
+		assert.Equal(t, []int64{testSegmentID}, campaigns[0].TriggerSegmentIDs)
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusUnauthorized, "v1/campaigns", "", "")
+
+		_, err = client.ListCampaigns()
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_GetCampaign will test the method GetCampaign()
+func TestClient_GetCampaign(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK, fmt.Sprintf("v1/campaigns/%d", testCampaignID), "",
+			`{"campaign":{"id":9,"name":"Onboarding","state":"running","tags":["onboarding"]}}`,
+		)
+
+		var campaign *Campaign
+		campaign, err = client.GetCampaign(testCampaignID)
+		assert.NoError(t, err)
+		assert.NotNil(t, campaign)
+		assert.Equal(t, "Onboarding", campaign.Name)
+		assert.Equal(t, "running", campaign.State)
+	})
+
+	t.Run("missing campaign id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetCampaign(0)
+		checkParamError(t, err, "campaignID")
+	})
+
+	t.Run("bad json", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK, fmt.Sprintf("v1/campaigns/%d", testCampaignID), "", `{"campaign":`)
+
+		_, err = client.GetCampaign(testCampaignID)
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_GetCampaignMetrics will test the method GetCampaignMetrics()
+func TestClient_GetCampaignMetrics(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK, fmt.Sprintf("v1/campaigns/%d/metrics", testCampaignID),
+			"period=days&steps=2&type=email", testMetricsResponse,
+		)
+
+		var series *MetricSeries
+		series, err = client.GetCampaignMetrics(testCampaignID, &MetricsOptions{
+			Period: MetricsPeriodDays, Steps: 2, Type: "email",
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, series)
+		assert.Equal(t, []int64{10, 20}, series.Sent)
+		assert.Equal(t, []int64{9, 19}, series.Delivered)
+		assert.Equal(t, []int64{5, 8}, series.Opened)
+		assert.Equal(t, []int64{2, 3}, series.Clicked)
+		assert.Equal(t, []int64{1, 0}, series.Converted)
+	})
+
+	t.Run("empty response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK, fmt.Sprintf("v1/campaigns/%d/metrics", testCampaignID), "", `{}`)
+
+		var series *MetricSeries
+		series, err = client.GetCampaignMetrics(testCampaignID, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, series)
+		assert.Empty(t, series.Sent)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetCampaignMetrics(0, nil)
+		checkParamError(t, err, "campaignID")
+
+		_, err = client.GetCampaignMetrics(testCampaignID, &MetricsOptions{Period: "years"})
+		checkParamError(t, err, "period")
+	})
+}
+
+// TestClient_GetCampaignLinkMetrics will test the method GetCampaignLinkMetrics()
+func TestClient_GetCampaignLinkMetrics(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK, fmt.Sprintf("v1/campaigns/%d/metrics/links", testCampaignID),
+			"unique=true", testLinkMetricsResponse,
+		)
+
+		var links []*LinkMetrics
+		links, err = client.GetCampaignLinkMetrics(testCampaignID, &MetricsOptions{Unique: true})
+		assert.NoError(t, err)
+		assert.Len(t, links, 1)
+		assert.Equal(t, testPageURL, links[0].Link.Href)
+		assert.Equal(t, []int64{4, 2}, links[0].Series.Clicked)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetCampaignLinkMetrics(0, nil)
+		checkParamError(t, err, "campaignID")
+
+		_, err = client.GetCampaignLinkMetrics(testCampaignID, &MetricsOptions{Period: "years"})
+		checkParamError(t, err, "period")
+	})
+}
+
+// TestClient_ListCampaignActions will test the method ListCampaignActions()
+func TestClient_ListCampaignActions(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK, fmt.Sprintf("v1/campaigns/%d/actions", testCampaignID), "",
+			`{"actions":[{"id":5,"campaign_id":9,"type":"email","subject":"Welcome"}],"next":""}`,
+		)
+
+		var actions []*Action
+		var next string
+		actions, next, err = client.ListCampaignActions(testCampaignID, nil)
+		assert.NoError(t, err)
+		assert.Len(t, actions, 1)
+		assert.Equal(t, "Welcome", actions[0].Subject)
+		assert.Equal(t, "", next)
+	})
+
+	t.Run("paginator", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK, fmt.Sprintf("v1/campaigns/%d/actions", testCampaignID), "",
+			`{"actions":[{"id":5}],"next":"abc"}`,
+		)
+		httpmock.RegisterResponder(http.MethodGet,
+			fmt.Sprintf("%sv1/campaigns/%d/actions?start=abc", testAppAPIURL, testCampaignID),
+			httpmock.NewStringResponder(http.StatusOK, `{"actions":[{"id":6}],"next":""}`),
+		)
+
+		var actions []*Action
+		actions, err = client.CampaignActionsPaginator(testCampaignID).All(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, actions, 2)
+	})
+
+	t.Run("missing campaign id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, _, err = client.ListCampaignActions(0, nil)
+		checkParamError(t, err, "campaignID")
+	})
+}
+
+// TestClient_GetCampaignAction will test the method GetCampaignAction()
+func TestClient_GetCampaignAction(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK, fmt.Sprintf("v1/campaigns/%d/actions/%d", testCampaignID, testActionID), "",
+			`{"action":{"id":5,"campaign_id":9,"type":"email","from":"team@example.com"}}`,
+		)
+
+		var action *Action
+		action, err = client.GetCampaignAction(testCampaignID, testActionID)
+		assert.NoError(t, err)
+		assert.NotNil(t, action)
+		assert.Equal(t, testCampaignID, action.CampaignID)
+		assert.Equal(t, "team@example.com", action.From)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetCampaignAction(0, testActionID)
+		checkParamError(t, err, "campaignID")
+
+		_, err = client.GetCampaignAction(testCampaignID, 0)
+		checkParamError(t, err, "actionID")
+	})
+}
+
+// TestClient_GetCampaignActionMetrics will test the method GetCampaignActionMetrics()
+func TestClient_GetCampaignActionMetrics(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK,
+			fmt.Sprintf("v1/campaigns/%d/actions/%d/metrics", testCampaignID, testActionID), "", testMetricsResponse,
+		)
+
+		var series *MetricSeries
+		series, err = client.GetCampaignActionMetrics(testCampaignID, testActionID, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{10, 20}, series.Sent)
+	})
+
+	t.Run("link metrics", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK,
+			fmt.Sprintf("v1/campaigns/%d/actions/%d/metrics/links", testCampaignID, testActionID), "",
+			testLinkMetricsResponse,
+		)
+
+		var links []*LinkMetrics
+		links, err = client.GetCampaignActionLinkMetrics(testCampaignID, testActionID, nil)
+		assert.NoError(t, err)
+		assert.Len(t, links, 1)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetCampaignActionMetrics(testCampaignID, 0, nil)
+		checkParamError(t, err, "actionID")
+
+		_, err = client.GetCampaignActionLinkMetrics(0, testActionID, nil)
+		checkParamError(t, err, "campaignID")
+	})
+}
+
+// ExampleClient_GetCampaignMetrics example using GetCampaignMetrics()
+//
+// See more examples in /examples/
+func ExampleClient_GetCampaignMetrics() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockAppResource(http.StatusOK, fmt.Sprintf("v1/campaigns/%d/metrics", testCampaignID),
+		"period=days&steps=2", testMetricsResponse,
+	)
+
+	// Get the last 2 days of metrics
+	var series *MetricSeries
+	if series, err = client.GetCampaignMetrics(testCampaignID, &MetricsOptions{
+		Period: MetricsPeriodDays, Steps: 2,
+	}); err != nil {
+		fmt.Printf("error getting metrics: %s", err.Error())
+		return
+	}
+	fmt.Printf("sent: %v opened: %v", series.Sent, series.Opened)
+	// Output:sent: [10 20] opened: [5 8]
+}
+
+// BenchmarkClient_GetCampaignMetrics benchmarks the method GetCampaignMetrics()
+func BenchmarkClient_GetCampaignMetrics(b *testing.B) {
+	client, _ := newTestClient()
+	mockAppResource(http.StatusOK, fmt.Sprintf("v1/campaigns/%d/metrics", testCampaignID), "", testMetricsResponse)
+	for i := 0; i < b.N; i++ {
+		_, _ = client.GetCampaignMetrics(testCampaignID, nil)
+	}
+}
@@ -1,11 +1,14 @@
 package customerio
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -26,8 +29,13 @@ type clientOptions struct {
 	appAPIKey      string        // App or Beta API key
 	betaURL        string        // Regional API endpoint (Beta URL)
 	httpTimeout    time.Duration // Default timeout in seconds for GET requests
+	logRedactions  []string      // Customer attributes redacted from the logged request bodies
+	logger         *slog.Logger  // If set, every request is logged (debug) and failures (warn / error)
+	middleware     []Middleware  // Middleware wrapping every request (the first is the outermost)
+	rateLimiter    *rateLimiter  // If set, requests are limited per API family
 	requestTracing bool          // If enabled, it will trace the request timing
 	retryCount     int           // Default retry count for HTTP requests
+	retryPolicy    *RetryPolicy  // If set, failed requests are retried using the policy (instead of retryCount)
 	siteID         string        // Used in conjunction with the Tracking API key
 	trackingAPIKey string        // Tracking API key (Only tracking API requests)
 	trackURL       string        // Regional Tracking API endpoint (URL)
@@ -86,13 +94,56 @@ func WithRequestTracing() ClientOps {
 }
 
 // WithRetryCount will overwrite the default retry count for http requests.
-// Default retries is 2.
+// Default retries is 2. Not used if a RetryPolicy is set.
 func WithRetryCount(retries int) ClientOps {
 	return func(c *clientOptions) {
 		c.retryCount = retries
 	}
 }
 
+// WithRetryPolicy will retry failed requests using the policy (backoff, jitter and Retry-After)
+// Only rate limits are retried for non-idempotent requests (POST) unless RetryNonIdempotent is set.
+// Default is no policy (the resty retry count is used).
+func WithRetryPolicy(policy *RetryPolicy) ClientOps {
+	return func(c *clientOptions) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithLogger will log every request at debug level and failures at warn (4xx) or error (5xx) level
+// API keys and the authorization are always redacted. See WithLogRedaction() for customer attributes.
+// Default is no logging.
+func WithLogger(logger *slog.Logger) ClientOps {
+	return func(c *clientOptions) {
+		c.logger = logger
+	}
+}
+
+// WithLogRedaction will overwrite the customer attributes redacted from the logged request bodies
+// Default is email and phone.
+func WithLogRedaction(attributes ...string) ClientOps {
+	return func(c *clientOptions) {
+		c.logRedactions = attributes
+	}
+}
+
+// WithMiddleware will wrap every request with the middleware (IE: headers, logging or metrics)
+// The first middleware is the outermost. Can be supplied multiple times (appends).
+func WithMiddleware(middleware ...Middleware) ClientOps {
+	return func(c *clientOptions) {
+		c.middleware = append(c.middleware, middleware...)
+	}
+}
+
+// WithRateLimit will limit the requests per second for each API family (track, app and transactional)
+// Requests block until allowed (or the context is done). Each retry attempt is also limited.
+// Default is no client-side rate limiting. See DefaultRateLimits()
+func WithRateLimit(limits RateLimits) ClientOps {
+	return func(c *clientOptions) {
+		c.rateLimiter = newRateLimiter(limits)
+	}
+}
+
 // WithUserAgent will overwrite the default useragent.
 // Default is package name + version.
 func WithUserAgent(userAgent string) ClientOps {
@@ -138,6 +189,7 @@ func defaultClientOptions() (opts *clientOptions) {
 		apiURL:         RegionUS.apiURL,
 		betaURL:        RegionUS.betaURL,
 		httpTimeout:    defaultHTTPTimeout,
+		logRedactions:  defaultLogRedactions,
 		requestTracing: false,
 		retryCount:     defaultRetryCount,
 		trackURL:       RegionUS.trackURL,
@@ -170,11 +222,25 @@ func NewClient(opts ...ClientOps) (*Client, error) {
 		client.httpClient = resty.New()
 		// Set defaults (for GET requests)
 		client.httpClient.SetTimeout(client.options.httpTimeout)
-		client.httpClient.SetRetryCount(client.options.retryCount)
+		if client.options.retryPolicy == nil {
+			client.httpClient.SetRetryCount(client.options.retryCount)
+		}
 	}
 	return client, nil
 }
 
+// apiFamily will return the API family for the request URL
+func (c *Client) apiFamily(requestURL string) APIFamily {
+	if strings.Contains(requestURL, c.options.trackURL) {
+		return APIFamilyTrack
+	} else if strings.Contains(requestURL, c.options.betaURL) {
+		return APIFamilyBeta
+	} else if strings.Contains(requestURL, "/v1/send/") {
+		return APIFamilyTransactional
+	}
+	return APIFamilyApp
+}
+
 // auth creates the Basic Auth string using the SiteID and API Key
 func (c *Client) auth() string {
 	return base64.URLEncoding.EncodeToString([]byte(fmt.Sprintf("%v:%v", c.options.siteID, c.options.trackingAPIKey)))
@@ -182,55 +248,102 @@ func (c *Client) auth() string {
 
 // request is a standard GET / POST / PUT / DELETE request for all outgoing HTTP requests
 // Omit the data attribute if using a GET request
-func (c *Client) request(httpMethod string, requestURL string,
+// The operation is the name of the method making the request (IE: UpdateCustomer)
+//
+// If the context is canceled or its deadline is exceeded, ctx.Err() is returned as-is
+// (context.Canceled or context.DeadlineExceeded) instead of an APIError
+//
+// The request is passed through the middleware (if any) before it is sent
+func (c *Client) request(ctx context.Context, operation, httpMethod, requestURL string,
 	data interface{}) (response StandardResponse, err error) {
 
-	// Set the user agent
-	req := c.httpClient.R().SetHeader("User-Agent", c.options.userAgent)
+	// Do not fire the request if the context is already done
+	if err = ctx.Err(); err != nil {
+		return
+	}
 
 	// Set the body if (PUT || POST)
+	req := &Request{
+		Family:    c.apiFamily(requestURL),
+		Header:    make(http.Header),
+		Method:    httpMethod,
+		Operation: "customerio." + operation,
+		URL:       requestURL,
+	}
 	if httpMethod != http.MethodGet && httpMethod != http.MethodDelete {
-		var j []byte
-		if j, err = json.Marshal(data); err != nil {
+		if req.Body, err = json.Marshal(data); err != nil {
 			return
 		}
-		req = req.SetBody(string(j))
-		req.Header.Add("Content-Length", strconv.Itoa(len(j)))
-		req.Header.Set("Content-Type", "application/json")
 	}
 
-	// Enable tracing
-	if c.options.requestTracing {
-		req.EnableTrace()
+	// Wrap the round trip with the middleware (the first middleware is the outermost)
+	roundTrip := c.roundTrip
+	for i := len(c.options.middleware) - 1; i >= 0; i-- {
+		roundTrip = c.options.middleware[i](roundTrip)
 	}
+	start := time.Now()
+	response, err = roundTrip(ctx, req)
+	c.logRequest(ctx, req, response, err, time.Since(start))
+	return
+}
 
-	// Set the authorization and content type
-	if strings.Contains(requestURL, c.options.trackURL) {
-		req.Header.Add("Authorization", "Basic "+c.auth())
-	} else { // App or Beta
-		req.Header.Set("Authorization", "Bearer "+c.options.appAPIKey)
+// getAppResource will fire a GET request for an App API resource (IE: "/v1/campaigns")
+// and unmarshal the response into v
+func (c *Client) getAppResource(ctx context.Context, operation, path string, values url.Values,
+	v interface{}) error {
+	response, err := c.request(
+		ctx,
+		operation,
+		http.MethodGet,
+		withQuery(fmt.Sprintf("%s%s", c.options.apiURL, path), values),
+		nil,
+	)
+	if err != nil {
+		return err
 	}
+	return json.Unmarshal(response.Body, v)
+}
 
-	// Fire the request
+// roundTrip will send the request and process the response
+//
+// If a RetryPolicy is set, failed requests are retried using the policy
+// If a rate limit is set, each attempt waits for the API family limit
+func (c *Client) roundTrip(ctx context.Context, req *Request) (response StandardResponse, err error) {
+
+	// Fire the request (and retry using the policy)
 	var resp *resty.Response
-	switch httpMethod {
-	case http.MethodPost:
-		if resp, err = req.Post(requestURL); err != nil {
+	policy := c.options.retryPolicy
+	for attempt := 1; ; attempt++ {
+		if err = c.options.rateLimiter.wait(ctx, req.Family); err != nil {
 			return
 		}
-	case http.MethodPut:
-		if resp, err = req.Put(requestURL); err != nil {
-			return
+		resp, err = c.fire(ctx, req)
+		response.Attempts = attempt
+		if policy == nil || attempt > policy.MaxRetries || !policy.retryable(ctx, req.Method, resp, err) {
+			break
 		}
-	case http.MethodDelete:
-		if resp, err = req.Delete(requestURL); err != nil {
-			return
+
+		// Wait before the next attempt unless the context is done (or the wait is too long)
+		wait, ok := policy.wait(attempt, resp)
+		if !ok {
+			break
 		}
-	case http.MethodGet:
-		if resp, err = req.Get(requestURL); err != nil {
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			err = ctx.Err()
 			return
 		}
 	}
+	if err != nil {
+		// Surface the context error directly (canceled or deadline exceeded)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = ctxErr
+		}
+		return
+	}
 
 	// Tracing enabled?
 	if c.options.requestTracing {
@@ -244,30 +357,54 @@ func (c *Client) request(httpMethod string, requestURL string,
 	// Process if error (different error formats for different API endpoint/urls)
 	// The Customer.io API only responds with 200 if successful
 	if http.StatusOK != response.StatusCode {
-		if strings.Contains(requestURL, "/v1/send/email") {
-			var meta struct {
-				Meta struct {
-					Err string `json:"error"`
-				} `json:"meta"`
-			}
-			if err = json.Unmarshal(response.Body, &meta); err != nil {
-				err = &TransactionalError{
-					StatusCode: response.StatusCode,
-					Err:        string(response.Body),
-				}
-			} else {
-				err = &TransactionalError{
-					StatusCode: response.StatusCode,
-					Err:        meta.Meta.Err,
-				}
-			}
+		apiErr := newAPIError(response.StatusCode, req.URL, response.Body)
+		if req.Family == APIFamilyTransactional { // Transactional API (email, push, sms)
+			err = newTransactionalError(apiErr)
 			return
 		}
-		err = &APIError{
-			status: response.StatusCode,
-			url:    requestURL,
-			body:   response.Body,
-		}
+		err = apiErr
 	}
 	return
 }
+
+// fire will create and send a single HTTP request
+func (c *Client) fire(ctx context.Context, r *Request) (*resty.Response, error) {
+
+	// Set the context, user agent and any headers set by the middleware
+	req := c.httpClient.R().SetContext(ctx).SetHeader("User-Agent", c.options.userAgent)
+	for key, values := range r.Header {
+		req.Header[key] = values
+	}
+
+	// Set the body if (PUT || POST)
+	if r.Method != http.MethodGet && r.Method != http.MethodDelete {
+		req = req.SetBody(string(r.Body))
+		req.Header.Set("Content-Length", strconv.Itoa(len(r.Body)))
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	// Enable tracing
+	if c.options.requestTracing {
+		req.EnableTrace()
+	}
+
+	// Set the authorization and content type
+	if r.Family == APIFamilyTrack {
+		req.Header.Add("Authorization", "Basic "+c.auth())
+	} else { // App or Beta
+		req.Header.Set("Authorization", "Bearer "+c.options.appAPIKey)
+	}
+
+	// Fire the request
+	switch r.Method {
+	case http.MethodPost:
+		return req.Post(r.URL)
+	case http.MethodPut:
+		return req.Put(r.URL)
+	case http.MethodDelete:
+		return req.Delete(r.URL)
+	case http.MethodGet:
+		return req.Get(r.URL)
+	}
+	return nil, fmt.Errorf("unsupported http method: %s", r.Method)
+}
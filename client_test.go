@@ -1,7 +1,10 @@
 package customerio
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
@@ -169,3 +172,74 @@ func BenchmarkDefaultClientOptions(b *testing.B) {
 		_ = defaultClientOptions()
 	}
 }
+
+// TestClient_request will test the method request() with a context
+func TestClient_request(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response (context)", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockTestAuth(http.StatusOK)
+
+		var resp StandardResponse
+		resp, err = client.request(
+			context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"auth", nil,
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockTestAuth(http.StatusOK)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err = client.request(ctx, "Test", http.MethodGet, testTrackingAPIURL+"auth", nil)
+		assert.Error(t, err)
+		assert.True(t, errors.Is(err, context.Canceled))
+
+		var apiErr *APIError
+		assert.False(t, errors.As(err, &apiErr))
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		httpmock.Reset()
+		httpmock.RegisterResponder(http.MethodGet, testTrackingAPIURL+"auth",
+			httpmock.NewStringResponder(http.StatusOK, "").Delay(50*time.Millisecond),
+		)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+
+		err = client.TestAuthWithContext(ctx)
+		assert.Error(t, err)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+
+	t.Run("api error is not a context error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockTestAuth(http.StatusUnauthorized)
+
+		err = client.TestAuthWithContext(context.Background())
+		assert.Error(t, err)
+		assert.False(t, errors.Is(err, context.Canceled))
+
+		var apiErr *APIError
+		assert.True(t, errors.As(err, &apiErr))
+	})
+}
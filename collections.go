@@ -1,55 +1,113 @@
 package customerio
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
+// ErrCollectionTooLarge is returned when the collection data is larger than the Customer.io limit
+var ErrCollectionTooLarge = fmt.Errorf("collection size limited to %d bytes", maxCollectionSize)
+
+// Collection is a collection of data (IE: events, products or locations) used in messages
+// See: https://customer.io/docs/api/app/#tag/Collections
+type Collection struct {
+	Bytes     int64  `json:"bytes"`
+	CreatedAt int64  `json:"created_at"`
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	Rows      int64  `json:"rows"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+// CreateCollection will create a collection with raw data and return the new collection (with its id)
+// See: https://customer.io/docs/api/app/#operation/addCollection
+//
+// The name of the collection. This is how you'll reference your collection in message.
+func (c *Client) CreateCollection(collectionName string, items []map[string]interface{}) (*Collection, error) {
+	return c.CreateCollectionWithContext(context.Background(), collectionName, items)
+}
+
+// CreateCollectionWithContext is the same as CreateCollection() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/addCollection
+func (c *Client) CreateCollectionWithContext(ctx context.Context, collectionName string,
+	items []map[string]interface{}) (*Collection, error) {
+	if collectionName == "" {
+		return nil, ParamError{Param: "collectionName"}
+	}
+	data, err := encodeCollectionItems(items)
+	if err != nil {
+		return nil, err
+	}
+	return c.createCollection(ctx, "CreateCollection", map[string]interface{}{
+		"data": data,
+		"name": collectionName,
+	})
+}
+
+// CreateCollectionViaURL will create a collection using a URL to a JSON file
+// and return the new collection (with its id)
+// See: https://customer.io/docs/api/app/#operation/addCollection
+//
+// If your URL does not include a Content-Type, Customer.io assumes your data is JSON.
+// This URL can also be a google sheet that you've shared with cio_share@customer.io.
+func (c *Client) CreateCollectionViaURL(collectionName, jsonURL string) (*Collection, error) {
+	return c.CreateCollectionViaURLWithContext(context.Background(), collectionName, jsonURL)
+}
+
+// CreateCollectionViaURLWithContext is the same as CreateCollectionViaURL() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/addCollection
+func (c *Client) CreateCollectionViaURLWithContext(ctx context.Context, collectionName,
+	jsonURL string) (*Collection, error) {
+	if collectionName == "" {
+		return nil, ParamError{Param: "collectionName"}
+	} else if jsonURL == "" {
+		return nil, ParamError{Param: "jsonURL"}
+	}
+	return c.createCollection(ctx, "CreateCollectionViaURL", map[string]interface{}{
+		"url":  jsonURL,
+		"name": collectionName,
+	})
+}
+
 // UpdateCollection will create or update a collection with raw data
-// See: https://customer.io/docs/api/#operation/addCollection
-// See: https://customer.io/docs/api/#operation/updateCollection
+// See: https://customer.io/docs/api/app/#operation/addCollection
+// See: https://customer.io/docs/api/app/#operation/updateCollection
 //
 // The name of the collection. This is how you'll reference your collection in message.
 // Updating the data or url for your collection fully replaces the contents of the collection.
 // Data example: {"data":[{"property1":null,"property2":null}]}}
+// Use CreateCollection() to get the id of a new collection
 func (c *Client) UpdateCollection(collectionID, collectionName string, items []map[string]interface{}) error {
+	return c.UpdateCollectionWithContext(context.Background(), collectionID, collectionName, items)
+}
+
+// UpdateCollectionWithContext is the same as UpdateCollection() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/addCollection
+// See: https://customer.io/docs/api/app/#operation/updateCollection
+func (c *Client) UpdateCollectionWithContext(ctx context.Context, collectionID, collectionName string,
+	items []map[string]interface{}) error {
 	if collectionName == "" {
 		return ParamError{Param: "collectionName"}
 	}
-	/*
-		if len(data) > 56000 {   // todo: is there a limit?
-			return errors.New("collection body size limited to 56000")
-		}
-	*/
-
-	// Create or Update (if id is given)
-	var err error
-	if len(collectionID) == 0 {
-		_, err = c.request(
-			http.MethodPost,
-			fmt.Sprintf("%s/v1/api/collections", c.options.betaURL),
-			map[string]interface{}{
-				"data": items,
-				"name": collectionName,
-			},
-		)
-	} else {
-		_, err = c.request(
-			http.MethodPut,
-			fmt.Sprintf("%s/v1/api/collections/%s", c.options.betaURL, collectionID),
-			map[string]interface{}{
-				"data": items,
-				"name": collectionName,
-			},
-		)
+	data, err := encodeCollectionItems(items)
+	if err != nil {
+		return err
 	}
 
+	// Create or Update (if id is given)
+	_, err = c.saveCollection(ctx, "UpdateCollection", collectionID, map[string]interface{}{
+		"data": data,
+		"name": collectionName,
+	})
 	return err
 }
 
 // UpdateCollectionViaURL will create or update a collection using a URL to a JSON file
-// See: https://customer.io/docs/api/#operation/addCollection
-// See: https://customer.io/docs/api/#operation/updateCollection
+// See: https://customer.io/docs/api/app/#operation/addCollection
+// See: https://customer.io/docs/api/app/#operation/updateCollection
 //
 // The name of the collection. This is how you'll reference your collection in message.
 //
@@ -57,32 +115,236 @@ func (c *Client) UpdateCollection(collectionID, collectionName string, items []m
 // If your URL does not include a Content-Type, Customer.io assumes your data is JSON.
 // This URL can also be a google sheet that you've shared with cio_share@customer.io.
 // Updating the data or url for your collection fully replaces the contents of the collection.
+// Use CreateCollectionViaURL() to get the id of a new collection
 func (c *Client) UpdateCollectionViaURL(collectionID, collectionName string, jsonURL string) error {
+	return c.UpdateCollectionViaURLWithContext(context.Background(), collectionID, collectionName, jsonURL)
+}
+
+// UpdateCollectionViaURLWithContext is the same as UpdateCollectionViaURL() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/addCollection
+// See: https://customer.io/docs/api/app/#operation/updateCollection
+func (c *Client) UpdateCollectionViaURLWithContext(ctx context.Context, collectionID, collectionName string,
+	jsonURL string) error {
+	if collectionName == "" {
+		return ParamError{Param: "collectionName"}
+	}
+
+	// Create or Update (if id is given)
+	_, err := c.saveCollection(ctx, "UpdateCollectionViaURL", collectionID, map[string]interface{}{
+		"url":  jsonURL,
+		"name": collectionName,
+	})
+	return err
+}
+
+// ListCollections will return all the collections in the workspace
+// See: https://customer.io/docs/api/app/#operation/getCollections
+func (c *Client) ListCollections() ([]*Collection, error) {
+	return c.ListCollectionsWithContext(context.Background())
+}
+
+// ListCollectionsWithContext is the same as ListCollections() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getCollections
+func (c *Client) ListCollectionsWithContext(ctx context.Context) ([]*Collection, error) {
+	var r struct {
+		Collections []*Collection `json:"collections"`
+	}
+	if err := c.getAppResource(ctx, "ListCollections", "/v1/collections", nil, &r); err != nil {
+		return nil, err
+	}
+	return r.Collections, nil
+}
+
+// GetCollection will return a collection (without its contents)
+// See: https://customer.io/docs/api/app/#operation/getCollection
+func (c *Client) GetCollection(collectionID string) (*Collection, error) {
+	return c.GetCollectionWithContext(context.Background(), collectionID)
+}
+
+// GetCollectionWithContext is the same as GetCollection() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getCollection
+func (c *Client) GetCollectionWithContext(ctx context.Context, collectionID string) (*Collection, error) {
+	if collectionID == "" {
+		return nil, ParamError{Param: "collectionID"}
+	}
+	var r struct {
+		Collection *Collection `json:"collection"`
+	}
+	if err := c.getAppResource(
+		ctx, "GetCollection", fmt.Sprintf("/v1/collections/%s", url.PathEscape(collectionID)), nil, &r,
+	); err != nil {
+		return nil, err
+	}
+	return r.Collection, nil
+}
+
+// GetCollectionContents will return the contents of a collection
+// See: https://customer.io/docs/api/app/#operation/getCollectionContents
+func (c *Client) GetCollectionContents(collectionID string) ([]map[string]interface{}, error) {
+	return c.GetCollectionContentsWithContext(context.Background(), collectionID)
+}
+
+// GetCollectionContentsWithContext is the same as GetCollectionContents() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getCollectionContents
+func (c *Client) GetCollectionContentsWithContext(ctx context.Context,
+	collectionID string) ([]map[string]interface{}, error) {
+	if collectionID == "" {
+		return nil, ParamError{Param: "collectionID"}
+	}
+	var items []map[string]interface{}
+	if err := c.getAppResource(
+		ctx, "GetCollectionContents", fmt.Sprintf("/v1/collections/%s/content", url.PathEscape(collectionID)), nil, &items,
+	); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// UpdateCollectionContents will replace the contents of a collection (the name is not changed)
+// See: https://customer.io/docs/api/app/#operation/updateCollectionContents
+func (c *Client) UpdateCollectionContents(collectionID string, items []map[string]interface{}) error {
+	return c.UpdateCollectionContentsWithContext(context.Background(), collectionID, items)
+}
+
+// UpdateCollectionContentsWithContext is the same as UpdateCollectionContents() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/updateCollectionContents
+func (c *Client) UpdateCollectionContentsWithContext(ctx context.Context, collectionID string,
+	items []map[string]interface{}) error {
+	if collectionID == "" {
+		return ParamError{Param: "collectionID"}
+	}
+	if items == nil {
+		items = []map[string]interface{}{}
+	}
+	data, err := encodeCollectionItems(items)
+	if err != nil {
+		return err
+	}
+	_, err = c.request(
+		ctx,
+		"UpdateCollectionContents",
+		http.MethodPut,
+		fmt.Sprintf("%s/v1/collections/%s/content", c.options.apiURL, url.PathEscape(collectionID)),
+		data,
+	)
+	return err
+}
+
+// DeleteCollection will delete a collection and its contents
+// See: https://customer.io/docs/api/app/#operation/deleteCollection
+func (c *Client) DeleteCollection(collectionID string) error {
+	return c.DeleteCollectionWithContext(context.Background(), collectionID)
+}
+
+// DeleteCollectionWithContext is the same as DeleteCollection() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/deleteCollection
+func (c *Client) DeleteCollectionWithContext(ctx context.Context, collectionID string) error {
+	if collectionID == "" {
+		return ParamError{Param: "collectionID"}
+	}
+	_, err := c.request(
+		ctx,
+		"DeleteCollection",
+		http.MethodDelete,
+		fmt.Sprintf("%s/v1/collections/%s", c.options.apiURL, url.PathEscape(collectionID)),
+		nil,
+	)
+	return err
+}
+
+// UpdateCollectionOf will create or update a collection using typed items (IE: a slice of structs)
+// Each item is encoded as JSON, use struct tags to set the property names
+// See: UpdateCollection() for the parameters
+func UpdateCollectionOf[T any](c *Client, collectionID, collectionName string, items []T) error {
+	return UpdateCollectionOfWithContext(context.Background(), c, collectionID, collectionName, items)
+}
+
+// UpdateCollectionOfWithContext is the same as UpdateCollectionOf() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/addCollection
+// See: https://customer.io/docs/api/app/#operation/updateCollection
+func UpdateCollectionOfWithContext[T any](ctx context.Context, c *Client, collectionID, collectionName string,
+	items []T) error {
 	if collectionName == "" {
 		return ParamError{Param: "collectionName"}
 	}
+	data, err := encodeCollectionItems(items)
+	if err != nil {
+		return err
+	}
 
 	// Create or Update (if id is given)
-	var err error
+	_, err = c.saveCollection(ctx, "UpdateCollectionOf", collectionID, map[string]interface{}{
+		"data": data,
+		"name": collectionName,
+	})
+	return err
+}
+
+// GetCollectionContentsAs will return the contents of a collection decoded into typed items
+// See: https://customer.io/docs/api/app/#operation/getCollectionContents
+func GetCollectionContentsAs[T any](c *Client, collectionID string) ([]T, error) {
+	return GetCollectionContentsAsWithContext[T](context.Background(), c, collectionID)
+}
+
+// GetCollectionContentsAsWithContext is the same as GetCollectionContentsAs() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getCollectionContents
+func GetCollectionContentsAsWithContext[T any](ctx context.Context, c *Client, collectionID string) ([]T, error) {
+	if collectionID == "" {
+		return nil, ParamError{Param: "collectionID"}
+	}
+	var items []T
+	if err := c.getAppResource(
+		ctx, "GetCollectionContentsAs", fmt.Sprintf("/v1/collections/%s/content", url.PathEscape(collectionID)), nil, &items,
+	); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// encodeCollectionItems will encode the items and check the size against the collection limit
+func encodeCollectionItems(items interface{}) (json.RawMessage, error) {
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	} else if len(data) > maxCollectionSize {
+		return nil, ErrCollectionTooLarge
+	}
+	return data, nil
+}
+
+// createCollection will create a collection and return the new collection
+func (c *Client) createCollection(ctx context.Context, operation string,
+	body map[string]interface{}) (*Collection, error) {
+	response, err := c.saveCollection(ctx, operation, "", body)
+	if err != nil {
+		return nil, err
+	}
+	var r struct {
+		Collection *Collection `json:"collection"`
+	}
+	if err = json.Unmarshal(response.Body, &r); err != nil {
+		return nil, err
+	}
+	return r.Collection, nil
+}
+
+// saveCollection will create a collection, or update the collection if the id is given
+func (c *Client) saveCollection(ctx context.Context, operation, collectionID string,
+	body map[string]interface{}) (StandardResponse, error) {
 	if len(collectionID) == 0 {
-		_, err = c.request(
+		return c.request(
+			ctx,
+			operation,
 			http.MethodPost,
-			fmt.Sprintf("%s/v1/api/collections", c.options.betaURL),
-			map[string]interface{}{
-				"url":  jsonURL,
-				"name": collectionName,
-			},
-		)
-	} else {
-		_, err = c.request(
-			http.MethodPut,
-			fmt.Sprintf("%s/v1/api/collections/%s", c.options.betaURL, collectionID),
-			map[string]interface{}{
-				"url":  jsonURL,
-				"name": collectionName,
-			},
+			fmt.Sprintf("%s/v1/collections", c.options.apiURL),
+			body,
 		)
 	}
-
-	return err
+	return c.request(
+		ctx,
+		operation,
+		http.MethodPut,
+		fmt.Sprintf("%s/v1/collections/%s", c.options.apiURL, url.PathEscape(collectionID)),
+		body,
+	)
 }
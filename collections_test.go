@@ -1,8 +1,11 @@
 package customerio
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -278,10 +281,417 @@ func BenchmarkClient_UpdateCollectionViaURL(b *testing.B) {
 	}
 }
 
+// TestClient_CreateCollection will test the method CreateCollection()
+func TestClient_CreateCollection(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockCollection(http.StatusOK, http.MethodPost, "", testCollectionResponse)
+
+		var collection *Collection
+		collection, err = client.CreateCollection(testCollectionName, []map[string]interface{}{
+			{"item_name": "test_item_1", "id_field": 1},
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, collection)
+		assert.Equal(t, int64(123), collection.ID)
+		assert.Equal(t, testCollectionName, collection.Name)
+	})
+
+	t.Run("via url", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockCollection(http.StatusOK, http.MethodPost, "", testCollectionResponse)
+
+		var collection *Collection
+		collection, err = client.CreateCollectionViaURL(testCollectionName, testCollectionURL)
+		assert.NoError(t, err)
+		assert.NotNil(t, collection)
+		assert.Equal(t, int64(2), collection.Rows)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.CreateCollection("", nil)
+		checkParamError(t, err, "collectionName")
+
+		_, err = client.CreateCollectionViaURL(testCollectionName, "")
+		checkParamError(t, err, "jsonURL")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockCollection(http.StatusUnprocessableEntity, http.MethodPost, "", "")
+
+		_, err = client.CreateCollection(testCollectionName, nil)
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_ListCollections will test the method ListCollections()
+func TestClient_ListCollections(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockCollection(http.StatusOK, http.MethodGet, "",
+			`{"collections":[{"id":123,"name":"test_collection","rows":2},{"id":124,"name":"products","rows":10}]}`,
+		)
+
+		var collections []*Collection
+		collections, err = client.ListCollections()
+		assert.NoError(t, err)
+		assert.Len(t, collections, 2)
+		assert.Equal(t, "products", collections[1].Name)
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockCollection(http.StatusUnauthorized, http.MethodGet, "", "")
+
+		_, err = client.ListCollections()
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_GetCollection will test the method GetCollection()
+func TestClient_GetCollection(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockCollection(http.StatusOK, http.MethodGet, "/"+testCollectionID, testCollectionResponse)
+
+		var collection *Collection
+		collection, err = client.GetCollection(testCollectionID)
+		assert.NoError(t, err)
+		assert.NotNil(t, collection)
+		assert.Equal(t, int64(1024), collection.Bytes)
+	})
+
+	t.Run("missing collection id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetCollection("")
+		checkParamError(t, err, "collectionID")
+	})
+}
+
+// TestClient_GetCollectionContents will test the method GetCollectionContents()
+func TestClient_GetCollectionContents(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockCollection(http.StatusOK, http.MethodGet, "/"+testCollectionID+"/content",
+			`[{"item_name":"test_item_1","id_field":1},{"item_name":"test_item_2","id_field":2}]`,
+		)
+
+		var items []map[string]interface{}
+		items, err = client.GetCollectionContents(testCollectionID)
+		assert.NoError(t, err)
+		assert.Len(t, items, 2)
+		assert.Equal(t, "test_item_2", items[1]["item_name"])
+	})
+
+	t.Run("missing collection id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetCollectionContents("")
+		checkParamError(t, err, "collectionID")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockCollection(http.StatusNotFound, http.MethodGet, "/"+testCollectionID+"/content", "")
+
+		_, err = client.GetCollectionContents(testCollectionID)
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_UpdateCollectionContents will test the method UpdateCollectionContents()
+func TestClient_UpdateCollectionContents(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockCollection(http.StatusOK, http.MethodPut, "/"+testCollectionID+"/content", testCollectionResponse)
+
+		err = client.UpdateCollectionContents(testCollectionID, []map[string]interface{}{
+			{"item_name": "test_item_1", "id_field": 1},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing collection id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		checkParamError(t, client.UpdateCollectionContents("", nil), "collectionID")
+	})
+}
+
+// TestClient_DeleteCollection will test the method DeleteCollection()
+func TestClient_DeleteCollection(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockCollection(http.StatusOK, http.MethodDelete, "/"+testCollectionID, "")
+
+		assert.NoError(t, client.DeleteCollection(testCollectionID))
+	})
+
+	t.Run("missing collection id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		checkParamError(t, client.DeleteCollection(""), "collectionID")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockCollection(http.StatusNotFound, http.MethodDelete, "/"+testCollectionID, "")
+
+		assert.Error(t, client.DeleteCollection(testCollectionID))
+	})
+}
+
+// ExampleClient_CreateCollection example using CreateCollection()
+//
+// See more examples in /examples/
+func ExampleClient_CreateCollection() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockCollection(http.StatusOK, http.MethodPost, "", testCollectionResponse)
+
+	// Create the collection
+	var collection *Collection
+	if collection, err = client.CreateCollection(testCollectionName, []map[string]interface{}{
+		{"item_name": "test_item_1", "id_field": 1},
+	}); err != nil {
+		fmt.Printf("error creating collection: %s", err.Error())
+		return
+	}
+	fmt.Printf("collection created: %d", collection.ID)
+	// Output:collection created: 123
+}
+
+// testCollectionItem is a typed collection item
+type testCollectionItem struct {
+	ID   int    `json:"id_field"`
+	Name string `json:"item_name"`
+}
+
+// TestUpdateCollectionOf will test the method UpdateCollectionOf()
+func TestUpdateCollectionOf(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response (create)", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockNewCollection(http.StatusOK)
+
+		err = UpdateCollectionOf(client, "", testCollectionName, []testCollectionItem{
+			{ID: 1, Name: "test_item_1"},
+			{ID: 2, Name: "test_item_2"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("successful response (update)", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		httpmock.Reset()
+		httpmock.RegisterResponder(http.MethodPut, fmt.Sprintf("%sv1/collections/%s", testAppAPIURL, testCollectionID),
+			func(req *http.Request) (*http.Response, error) {
+				var body struct {
+					Data []testCollectionItem `json:"data"`
+					Name string               `json:"name"`
+				}
+				if err := json.NewDecoder(req.Body).Decode(&body); err != nil ||
+					len(body.Data) != 1 || body.Data[0].Name != "test_item_1" || body.Name != testCollectionName {
+					return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+				}
+				return httpmock.NewStringResponse(http.StatusOK, ""), nil
+			},
+		)
+
+		err = UpdateCollectionOf(client, testCollectionID, testCollectionName, []*testCollectionItem{
+			{ID: 1, Name: "test_item_1"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing collection name", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = UpdateCollectionOf(client, testCollectionID, "", []testCollectionItem{{ID: 1}})
+		checkParamError(t, err, "collectionName")
+	})
+
+	t.Run("collection too large", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockNewCollection(http.StatusOK)
+
+		err = UpdateCollectionOf(client, "", testCollectionName, []testCollectionItem{
+			{ID: 1, Name: strings.Repeat("a", maxCollectionSize)},
+		})
+		assert.True(t, errors.Is(err, ErrCollectionTooLarge))
+		assert.Equal(t, 0, httpmock.GetTotalCallCount())
+	})
+
+	t.Run("invalid items", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = UpdateCollectionOf(client, "", testCollectionName, []func(){func() {}})
+		assert.Error(t, err)
+	})
+}
+
+// TestGetCollectionContentsAs will test the method GetCollectionContentsAs()
+func TestGetCollectionContentsAs(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockCollection(http.StatusOK, http.MethodGet, "/"+testCollectionID+"/content",
+			`[{"item_name":"test_item_1","id_field":1},{"item_name":"test_item_2","id_field":2}]`,
+		)
+
+		var items []testCollectionItem
+		items, err = GetCollectionContentsAs[testCollectionItem](client, testCollectionID)
+		assert.NoError(t, err)
+		assert.Equal(t, []testCollectionItem{{ID: 1, Name: "test_item_1"}, {ID: 2, Name: "test_item_2"}}, items)
+	})
+
+	t.Run("missing collection id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = GetCollectionContentsAs[testCollectionItem](client, "")
+		checkParamError(t, err, "collectionID")
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockCollection(http.StatusOK, http.MethodGet, "/"+testCollectionID+"/content", `[{"id_field":"one"}]`)
+
+		_, err = GetCollectionContentsAs[testCollectionItem](client, testCollectionID)
+		assert.Error(t, err)
+	})
+}
+
+// ExampleUpdateCollectionOf example using UpdateCollectionOf()
+//
+// See more examples in /examples/
+func ExampleUpdateCollectionOf() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockUpdateCollection(http.StatusOK, testCollectionID)
+
+	// Update the collection using typed items
+	if err = UpdateCollectionOf(client, testCollectionID, testCollectionName, []testCollectionItem{
+		{ID: 1, Name: "test_item_1"},
+	}); err != nil {
+		fmt.Printf("error updating collection: %s", err.Error())
+		return
+	}
+	fmt.Printf("collection updated: %s", testCollectionName)
+	// Output:collection updated: test_collection
+}
+
+// testCollectionResponse is the response for a single collection
+const testCollectionResponse = `{"collection":{"id":123,"name":"test_collection","bytes":1024,"rows":2,` +
+	`"created_at":1600000000,"updated_at":1600000060}}`
+
+// mockCollection is used for mocking the response
+func mockCollection(statusCode int, method, path, body string) {
+	httpmock.Reset()
+	httpmock.RegisterResponder(method, fmt.Sprintf("%sv1/collections%s", testAppAPIURL, path),
+		httpmock.NewStringResponder(
+			statusCode, body,
+		),
+	)
+}
+
 // mockNewCollection is used for mocking the response
 func mockNewCollection(statusCode int) {
 	httpmock.Reset()
-	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%sv1/api/collections", testBetaAPIURL),
+	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%sv1/collections", testAppAPIURL),
 		httpmock.NewStringResponder(
 			statusCode, "",
 		),
@@ -291,7 +701,7 @@ func mockNewCollection(statusCode int) {
 // mockUpdateCollection is used for mocking the response
 func mockUpdateCollection(statusCode int, collectionID string) {
 	httpmock.Reset()
-	httpmock.RegisterResponder(http.MethodPut, fmt.Sprintf("%sv1/api/collections/%s", testBetaAPIURL, collectionID),
+	httpmock.RegisterResponder(http.MethodPut, fmt.Sprintf("%sv1/collections/%s", testAppAPIURL, collectionID),
 		httpmock.NewStringResponder(
 			statusCode, "",
 		),
@@ -301,7 +711,7 @@ func mockUpdateCollection(statusCode int, collectionID string) {
 // mockNewCollectionViaURL is used for mocking the response
 func mockNewCollectionViaURL(statusCode int) {
 	httpmock.Reset()
-	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%sv1/api/collections", testBetaAPIURL),
+	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%sv1/collections", testAppAPIURL),
 		httpmock.NewStringResponder(
 			statusCode, "",
 		),
@@ -311,7 +721,7 @@ func mockNewCollectionViaURL(statusCode int) {
 // mockUpdateCollectionViaURL is used for mocking the response
 func mockUpdateCollectionViaURL(statusCode int, collectionID string) {
 	httpmock.Reset()
-	httpmock.RegisterResponder(http.MethodPut, fmt.Sprintf("%sv1/api/collections/%s", testBetaAPIURL, collectionID),
+	httpmock.RegisterResponder(http.MethodPut, fmt.Sprintf("%sv1/collections/%s", testAppAPIURL, collectionID),
 		httpmock.NewStringResponder(
 			statusCode, "",
 		),
@@ -1,8 +1,8 @@
 package customerio
 
 const (
+	testAnonymousID    = "anon-abcdefghijklmnopqrstuvwxyz"
 	testAppAPIURL      = "https://api.customer.io/"
-	testBetaAPIURL     = "https://beta-api.customer.io/"
 	testCollectionID   = "123"
 	testCollectionName = "test_collection"
 	testCollectionURL  = "https://example.com/some-path/collection.json"
@@ -10,5 +10,7 @@ const (
 	testCustomerID     = "123"
 	testDeviceID       = "abcdefghijklmnopqrstuvwxyz"
 	testEventName      = "test_event"
+	testPageURL        = "https://example.com/pricing"
+	testScreenName     = "Pricing"
 	testTrackingAPIURL = "https://track.customer.io/"
 )
@@ -1,6 +1,7 @@
 package customerio
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -12,10 +13,25 @@ import (
 // AKA: Identify()
 // Only use "email" if the workspace is setup to use email instead of ID
 func (c *Client) UpdateCustomer(customerIDOrEmail string, attributes map[string]interface{}) error {
+	return c.UpdateCustomerWithContext(context.Background(), customerIDOrEmail, attributes)
+}
+
+// UpdateCustomerWithContext is the same as UpdateCustomer() but uses the given context
+// See: https://customer.io/docs/api/#operation/identify
+func (c *Client) UpdateCustomerWithContext(ctx context.Context, customerIDOrEmail string,
+	attributes map[string]interface{}) error {
+	return c.updateCustomer(ctx, "UpdateCustomer", customerIDOrEmail, attributes)
+}
+
+// updateCustomer will add/update a customer using the Track API
+func (c *Client) updateCustomer(ctx context.Context, operation, customerIDOrEmail string,
+	attributes map[string]interface{}) error {
 	if customerIDOrEmail == "" {
 		return ParamError{Param: "customerIDOrEmail"}
 	}
 	_, err := c.request(
+		ctx,
+		operation,
 		http.MethodPut,
 		fmt.Sprintf("%s/api/v1/customers/%s", c.options.trackURL, url.PathEscape(customerIDOrEmail)),
 		attributes,
@@ -23,15 +39,49 @@ func (c *Client) UpdateCustomer(customerIDOrEmail string, attributes map[string]
 	return err
 }
 
+// IdentifyAnonymous will add/update a customer and associate them with an anonymous id
+// Any events sent using the anonymous id (see: TrackAnonymousEvent()) are added to the customer's activity
+// See: https://customer.io/docs/api/track/#operation/identify
+// Only use "email" if the workspace is setup to use email instead of ID
+func (c *Client) IdentifyAnonymous(customerIDOrEmail, anonymousID string, attributes map[string]interface{}) error {
+	return c.IdentifyAnonymousWithContext(context.Background(), customerIDOrEmail, anonymousID, attributes)
+}
+
+// IdentifyAnonymousWithContext is the same as IdentifyAnonymous() but uses the given context
+// See: https://customer.io/docs/api/track/#operation/identify
+func (c *Client) IdentifyAnonymousWithContext(ctx context.Context, customerIDOrEmail, anonymousID string,
+	attributes map[string]interface{}) error {
+	if anonymousID == "" {
+		return ParamError{Param: "anonymousID"}
+	}
+
+	// Copy the attributes (do not modify the given map)
+	withID := make(map[string]interface{}, len(attributes)+1)
+	for key, value := range attributes {
+		withID[key] = value
+	}
+	withID["anonymous_id"] = anonymousID
+
+	return c.updateCustomer(ctx, "IdentifyAnonymous", customerIDOrEmail, withID)
+}
+
 // DeleteCustomer will remove a customer given their id or email
 // If not found, a customer will be created. If found, the attributes will be updated
 // See: https://customer.io/docs/api/#operation/delete
 // Only use "email" if the workspace is setup to use email instead of ID
 func (c *Client) DeleteCustomer(customerIDOrEmail string) error {
+	return c.DeleteCustomerWithContext(context.Background(), customerIDOrEmail)
+}
+
+// DeleteCustomerWithContext is the same as DeleteCustomer() but uses the given context
+// See: https://customer.io/docs/api/#operation/delete
+func (c *Client) DeleteCustomerWithContext(ctx context.Context, customerIDOrEmail string) error {
 	if customerIDOrEmail == "" {
 		return ParamError{Param: "customerIDOrEmail"}
 	}
 	_, err := c.request(
+		ctx,
+		"DeleteCustomer",
 		http.MethodDelete,
 		fmt.Sprintf("%s/api/v1/customers/%s", c.options.trackURL, url.PathEscape(customerIDOrEmail)),
 		nil,
@@ -39,11 +89,93 @@ func (c *Client) DeleteCustomer(customerIDOrEmail string) error {
 	return err
 }
 
+// SuppressCustomer will delete a customer and prevent them from being added again (IE: GDPR requests)
+// See: https://customer.io/docs/api/track/#operation/suppress
+// Only use "email" if the workspace is setup to use email instead of ID
+func (c *Client) SuppressCustomer(customerIDOrEmail string) error {
+	return c.SuppressCustomerWithContext(context.Background(), customerIDOrEmail)
+}
+
+// SuppressCustomerWithContext is the same as SuppressCustomer() but uses the given context
+// See: https://customer.io/docs/api/track/#operation/suppress
+func (c *Client) SuppressCustomerWithContext(ctx context.Context, customerIDOrEmail string) error {
+	if customerIDOrEmail == "" {
+		return ParamError{Param: "customerIDOrEmail"}
+	}
+	_, err := c.request(
+		ctx,
+		"SuppressCustomer",
+		http.MethodPost,
+		fmt.Sprintf("%s/api/v1/customers/%s/suppress", c.options.trackURL, url.PathEscape(customerIDOrEmail)),
+		nil,
+	)
+	return err
+}
+
+// UnsuppressCustomer will allow a previously suppressed customer to be added again
+// This does not restore the customer's previous data
+// See: https://customer.io/docs/api/track/#operation/unsuppress
+// Only use "email" if the workspace is setup to use email instead of ID
+func (c *Client) UnsuppressCustomer(customerIDOrEmail string) error {
+	return c.UnsuppressCustomerWithContext(context.Background(), customerIDOrEmail)
+}
+
+// UnsuppressCustomerWithContext is the same as UnsuppressCustomer() but uses the given context
+// See: https://customer.io/docs/api/track/#operation/unsuppress
+func (c *Client) UnsuppressCustomerWithContext(ctx context.Context, customerIDOrEmail string) error {
+	if customerIDOrEmail == "" {
+		return ParamError{Param: "customerIDOrEmail"}
+	}
+	_, err := c.request(
+		ctx,
+		"UnsuppressCustomer",
+		http.MethodPost,
+		fmt.Sprintf("%s/api/v1/customers/%s/unsuppress", c.options.trackURL, url.PathEscape(customerIDOrEmail)),
+		nil,
+	)
+	return err
+}
+
+// MergeCustomers will merge the secondary customer into the primary customer
+// The secondary customer is deleted and their attributes, devices and activity are added to the primary
+// See: https://customer.io/docs/api/track/#operation/merge
+func (c *Client) MergeCustomers(primary, secondary Identifier) error {
+	return c.MergeCustomersWithContext(context.Background(), primary, secondary)
+}
+
+// MergeCustomersWithContext is the same as MergeCustomers() but uses the given context
+// See: https://customer.io/docs/api/track/#operation/merge
+func (c *Client) MergeCustomersWithContext(ctx context.Context, primary, secondary Identifier) error {
+	if !primary.valid() {
+		return ParamError{Param: "primary"}
+	}
+	if !secondary.valid() {
+		return ParamError{Param: "secondary"}
+	}
+	_, err := c.request(
+		ctx,
+		"MergeCustomers",
+		http.MethodPost,
+		fmt.Sprintf("%s/api/v1/merge_customers", c.options.trackURL),
+		map[string]interface{}{
+			"primary":   primary,
+			"secondary": secondary,
+		},
+	)
+	return err
+}
+
 // UpdateDevice will add/update a customer's device
 // If not found, a device will be created. If found, the attributes will be updated
 // See: https://customer.io/docs/api/#operation/add_device
 // Only use "email" if the workspace is setup to use email instead of ID
 func (c *Client) UpdateDevice(customerIDOrEmail string, device *Device) error {
+	return c.UpdateDeviceWithContext(context.Background(), customerIDOrEmail, device)
+}
+
+// UpdateDeviceWithContext is the same as UpdateDevice() but uses the given context
+// See: https://customer.io/docs/api/#operation/add_device
+func (c *Client) UpdateDeviceWithContext(ctx context.Context, customerIDOrEmail string, device *Device) error {
 	if customerIDOrEmail == "" {
 		return ParamError{Param: "customerIDOrEmail"}
 	}
@@ -55,6 +187,8 @@ func (c *Client) UpdateDevice(customerIDOrEmail string, device *Device) error {
 		return ParamError{Param: "devicePlatform"}
 	}
 	_, err := c.request(
+		ctx,
+		"UpdateDevice",
 		http.MethodPut,
 		fmt.Sprintf("%s/api/v1/customers/%s/devices", c.options.trackURL, url.PathEscape(customerIDOrEmail)),
 		map[string]interface{}{
@@ -68,6 +202,12 @@ func (c *Client) UpdateDevice(customerIDOrEmail string, device *Device) error {
 // See: https://customer.io/docs/api/#operation/delete_device
 // Only use "email" if the workspace is setup to use email instead of ID
 func (c *Client) DeleteDevice(customerIDOrEmail, deviceID string) error {
+	return c.DeleteDeviceWithContext(context.Background(), customerIDOrEmail, deviceID)
+}
+
+// DeleteDeviceWithContext is the same as DeleteDevice() but uses the given context
+// See: https://customer.io/docs/api/#operation/delete_device
+func (c *Client) DeleteDeviceWithContext(ctx context.Context, customerIDOrEmail, deviceID string) error {
 	if customerIDOrEmail == "" {
 		return ParamError{Param: "customerIDOrEmail"}
 	}
@@ -75,6 +215,8 @@ func (c *Client) DeleteDevice(customerIDOrEmail, deviceID string) error {
 		return ParamError{Param: "deviceID"}
 	}
 	_, err := c.request(
+		ctx,
+		"DeleteDevice",
 		http.MethodDelete,
 		fmt.Sprintf(
 			"%s/api/v1/customers/%s/devices/%s",
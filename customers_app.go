@@ -0,0 +1,327 @@
+package customerio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Customer is a customer profile from the App API
+type Customer struct {
+	Attributes   map[string]interface{} `json:"attributes"`
+	Devices      []*Device              `json:"devices"`
+	ID           string                 `json:"id"`
+	Identifiers  CustomerIdentifiers    `json:"identifiers"`
+	Timestamps   map[string]int64       `json:"timestamps"` // Timestamps is when each attribute was last updated
+	Unsubscribed bool                   `json:"unsubscribed"`
+}
+
+// CustomerIdentifiers are all the identifiers for a customer
+type CustomerIdentifiers struct {
+	CioID string `json:"cio_id"`
+	Email string `json:"email"`
+	ID    string `json:"id"`
+}
+
+// Message is a message sent to a customer
+type Message struct {
+	ActionID            int64               `json:"action_id"`
+	BroadcastID         int64               `json:"broadcast_id"`
+	CampaignID          int64               `json:"campaign_id"`
+	ContentID           int64               `json:"content_id"`
+	Created             int64               `json:"created"`
+	CustomerID          string              `json:"customer_id"`
+	CustomerIdentifiers CustomerIdentifiers `json:"customer_identifiers"`
+	DeduplicateID       string              `json:"deduplicate_id"`
+	FailureMessage      string              `json:"failure_message"`
+	Forgotten           bool                `json:"forgotten"`
+	ID                  string              `json:"id"`
+	// Metrics is when each metric occurred (sent, delivered, opened...)
+	Metrics      map[string]int64 `json:"metrics"`
+	NewsletterID int64            `json:"newsletter_id"`
+	Recipient    string           `json:"recipient"`
+	Subject      string           `json:"subject"`
+	TemplateID   int64            `json:"msg_template_id"`
+	Type         string           `json:"type"`
+}
+
+// Activity is an activity performed by (or for) a customer
+type Activity struct {
+	CustomerID          string                 `json:"customer_id"`
+	CustomerIdentifiers CustomerIdentifiers    `json:"customer_identifiers"`
+	Data                map[string]interface{} `json:"data"`
+	DeliveryID          string                 `json:"delivery_id"`
+	DeliveryType        string                 `json:"delivery_type"`
+	ID                  string                 `json:"id"`
+	Name                string                 `json:"name"`
+	Timestamp           int64                  `json:"timestamp"`
+	Type                string                 `json:"type"`
+}
+
+// ActivityListOptions are the options for listing a customer's activities
+type ActivityListOptions struct {
+	ListOptions
+	Name string // Name is the event or attribute name (only for event and attribute_change types)
+	Type string // Type is the type of activity (IE: "event", "attribute_change", "sent_email")
+}
+
+// CustomerFilter is the filter used when searching for customers
+// Only set one field per filter, combine filters using And, Or or Not
+// See: https://customer.io/docs/api/app/#operation/getPeopleFilter
+type CustomerFilter struct {
+	And       []*CustomerFilter `json:"and,omitempty"`
+	Attribute *AttributeFilter  `json:"attribute,omitempty"`
+	Not       *CustomerFilter   `json:"not,omitempty"`
+	Or        []*CustomerFilter `json:"or,omitempty"`
+	Segment   *SegmentFilter    `json:"segment,omitempty"`
+}
+
+// AttributeFilter will match customers by an attribute
+type AttributeFilter struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator"` // Operator is either "eq" or "exists"
+	Value    string `json:"value,omitempty"`
+}
+
+// SegmentFilter will match customers in a segment
+type SegmentFilter struct {
+	ID int64 `json:"id"`
+}
+
+// GetCustomer will return a customer's attributes, identifiers and devices
+// See: https://customer.io/docs/api/app/#operation/getPersonAttributes
+// The idType is the type of the customerID (id, email or cio_id), defaults to id
+func (c *Client) GetCustomer(customerID string, idType IDType) (*Customer, error) {
+	return c.GetCustomerWithContext(context.Background(), customerID, idType)
+}
+
+// GetCustomerWithContext is the same as GetCustomer() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getPersonAttributes
+func (c *Client) GetCustomerWithContext(ctx context.Context, customerID string, idType IDType) (*Customer, error) {
+	return c.getCustomer(ctx, "GetCustomer", customerID, idType)
+}
+
+// getCustomer will return a customer's attributes and devices using the App API
+func (c *Client) getCustomer(ctx context.Context, operation, customerID string, idType IDType) (*Customer, error) {
+	var r struct {
+		Customer *Customer `json:"customer"`
+	}
+	if err := c.getCustomerResource(ctx, operation, customerID, idType, "attributes", nil, &r); err != nil {
+		return nil, err
+	}
+	return r.Customer, nil
+}
+
+// GetCustomerSegments will return the segments a customer belongs to
+// See: https://customer.io/docs/api/app/#operation/getPersonSegments
+func (c *Client) GetCustomerSegments(customerID string, idType IDType) ([]*Segment, error) {
+	return c.GetCustomerSegmentsWithContext(context.Background(), customerID, idType)
+}
+
+// GetCustomerSegmentsWithContext is the same as GetCustomerSegments() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getPersonSegments
+func (c *Client) GetCustomerSegmentsWithContext(ctx context.Context, customerID string,
+	idType IDType) ([]*Segment, error) {
+	var r struct {
+		Segments []*Segment `json:"segments"`
+	}
+	if err := c.getCustomerResource(ctx, "GetCustomerSegments", customerID, idType, "segments", nil, &r); err != nil {
+		return nil, err
+	}
+	return r.Segments, nil
+}
+
+// GetCustomerDevices will return a customer's devices
+// See: https://customer.io/docs/api/app/#operation/getPersonAttributes
+func (c *Client) GetCustomerDevices(customerID string, idType IDType) ([]*Device, error) {
+	return c.GetCustomerDevicesWithContext(context.Background(), customerID, idType)
+}
+
+// GetCustomerDevicesWithContext is the same as GetCustomerDevices() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getPersonAttributes
+func (c *Client) GetCustomerDevicesWithContext(ctx context.Context, customerID string,
+	idType IDType) ([]*Device, error) {
+	customer, err := c.getCustomer(ctx, "GetCustomerDevices", customerID, idType)
+	if err != nil {
+		return nil, err
+	} else if customer == nil {
+		return nil, nil
+	}
+	return customer.Devices, nil
+}
+
+// GetCustomerMessages will return a page of messages sent to a customer and the cursor for the next page
+// See: https://customer.io/docs/api/app/#operation/getPersonMessages
+func (c *Client) GetCustomerMessages(customerID string, idType IDType,
+	opts *ListOptions) ([]*Message, string, error) {
+	return c.GetCustomerMessagesWithContext(context.Background(), customerID, idType, opts)
+}
+
+// GetCustomerMessagesWithContext is the same as GetCustomerMessages() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getPersonMessages
+func (c *Client) GetCustomerMessagesWithContext(ctx context.Context, customerID string, idType IDType,
+	opts *ListOptions) ([]*Message, string, error) {
+	var r struct {
+		Messages []*Message `json:"messages"`
+		Next     string     `json:"next"`
+	}
+	if err := c.getCustomerResource(
+		ctx, "GetCustomerMessages", customerID, idType, "messages", opts.values(), &r,
+	); err != nil {
+		return nil, "", err
+	}
+	return r.Messages, r.Next, nil
+}
+
+// CustomerMessagesPaginator will return a paginator over all the messages sent to a customer
+// See: https://customer.io/docs/api/app/#operation/getPersonMessages
+func (c *Client) CustomerMessagesPaginator(customerID string, idType IDType,
+	opts ...PaginatorOps) *Paginator[*Message] {
+	return NewPaginator(func(ctx context.Context, list *ListOptions) ([]*Message, string, error) {
+		return c.GetCustomerMessagesWithContext(ctx, customerID, idType, list)
+	}, opts...)
+}
+
+// GetCustomerActivities will return a page of a customer's activities and the cursor for the next page
+// See: https://customer.io/docs/api/app/#operation/getPersonActivities
+func (c *Client) GetCustomerActivities(customerID string, idType IDType,
+	opts *ActivityListOptions) ([]*Activity, string, error) {
+	return c.GetCustomerActivitiesWithContext(context.Background(), customerID, idType, opts)
+}
+
+// GetCustomerActivitiesWithContext is the same as GetCustomerActivities() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getPersonActivities
+func (c *Client) GetCustomerActivitiesWithContext(ctx context.Context, customerID string, idType IDType,
+	opts *ActivityListOptions) ([]*Activity, string, error) {
+	values := url.Values{}
+	if opts != nil {
+		values = opts.ListOptions.values()
+		if opts.Type != "" {
+			values.Set("type", opts.Type)
+		}
+		if opts.Name != "" {
+			values.Set("name", opts.Name)
+		}
+	}
+	var r struct {
+		Activities []*Activity `json:"activities"`
+		Next       string      `json:"next"`
+	}
+	if err := c.getCustomerResource(
+		ctx, "GetCustomerActivities", customerID, idType, "activities", values, &r,
+	); err != nil {
+		return nil, "", err
+	}
+	return r.Activities, r.Next, nil
+}
+
+// CustomerActivitiesPaginator will return a paginator over all of a customer's activities
+// The activityType and activityName are optional filters
+// See: https://customer.io/docs/api/app/#operation/getPersonActivities
+func (c *Client) CustomerActivitiesPaginator(customerID string, idType IDType, activityType, activityName string,
+	opts ...PaginatorOps) *Paginator[*Activity] {
+	return NewPaginator(func(ctx context.Context, list *ListOptions) ([]*Activity, string, error) {
+		return c.GetCustomerActivitiesWithContext(ctx, customerID, idType, &ActivityListOptions{
+			ListOptions: *list,
+			Name:        activityName,
+			Type:        activityType,
+		})
+	}, opts...)
+}
+
+// FindCustomersByEmail will return the identifiers of all customers with the email address
+// See: https://customer.io/docs/api/app/#operation/getPeopleEmail
+func (c *Client) FindCustomersByEmail(email string) ([]*CustomerIdentifiers, error) {
+	return c.FindCustomersByEmailWithContext(context.Background(), email)
+}
+
+// FindCustomersByEmailWithContext is the same as FindCustomersByEmail() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getPeopleEmail
+func (c *Client) FindCustomersByEmailWithContext(ctx context.Context, email string) ([]*CustomerIdentifiers, error) {
+	if email == "" {
+		return nil, ParamError{Param: "email"}
+	}
+	response, err := c.request(
+		ctx,
+		"FindCustomersByEmail",
+		http.MethodGet,
+		withQuery(fmt.Sprintf("%s/v1/customers", c.options.apiURL), url.Values{"email": []string{email}}),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var r struct {
+		Results []*CustomerIdentifiers `json:"results"`
+	}
+	if err = json.Unmarshal(response.Body, &r); err != nil {
+		return nil, err
+	}
+	return r.Results, nil
+}
+
+// SearchCustomers will return a page of customers matching the filter and the cursor for the next page
+// See: https://customer.io/docs/api/app/#operation/getPeopleFilter
+func (c *Client) SearchCustomers(filter *CustomerFilter, opts *ListOptions) ([]*CustomerIdentifiers, string, error) {
+	return c.SearchCustomersWithContext(context.Background(), filter, opts)
+}
+
+// SearchCustomersWithContext is the same as SearchCustomers() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getPeopleFilter
+func (c *Client) SearchCustomersWithContext(ctx context.Context, filter *CustomerFilter,
+	opts *ListOptions) ([]*CustomerIdentifiers, string, error) {
+	if filter == nil {
+		return nil, "", ParamError{Param: "filter"}
+	}
+	response, err := c.request(
+		ctx,
+		"SearchCustomers",
+		http.MethodPost,
+		withQuery(fmt.Sprintf("%s/v1/customers", c.options.apiURL), opts.values()),
+		map[string]interface{}{
+			"filter": filter,
+		},
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	var r struct {
+		Identifiers []*CustomerIdentifiers `json:"identifiers"`
+		Next        string                 `json:"next"`
+	}
+	if err = json.Unmarshal(response.Body, &r); err != nil {
+		return nil, "", err
+	}
+	return r.Identifiers, r.Next, nil
+}
+
+// SearchCustomersPaginator will return a paginator over all the customers matching the filter
+// See: https://customer.io/docs/api/app/#operation/getPeopleFilter
+func (c *Client) SearchCustomersPaginator(filter *CustomerFilter,
+	opts ...PaginatorOps) *Paginator[*CustomerIdentifiers] {
+	return NewPaginator(func(ctx context.Context, list *ListOptions) ([]*CustomerIdentifiers, string, error) {
+		return c.SearchCustomersWithContext(ctx, filter, list)
+	}, opts...)
+}
+
+// getCustomerResource will fire a GET request for a customer's resource (attributes, segments...)
+// and unmarshal the response into v
+func (c *Client) getCustomerResource(ctx context.Context, operation, customerID string, idType IDType,
+	resource string, values url.Values, v interface{}) error {
+	if customerID == "" {
+		return ParamError{Param: "customerID"}
+	} else if !acceptedIDTypes(idType) {
+		return ParamError{Param: "idType"}
+	}
+	if values == nil {
+		values = url.Values{}
+	}
+	if idType != "" {
+		values.Set("id_type", string(idType))
+	}
+	return c.getAppResource(
+		ctx, operation, fmt.Sprintf("/v1/customers/%s/%s", url.PathEscape(customerID), resource), values, v,
+	)
+}
@@ -0,0 +1,352 @@
+package customerio
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestClient_GetCustomer will test the method GetCustomer()
+func TestClient_GetCustomer(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockGetCustomerResource(http.StatusOK, testCustomerID, "attributes", "", testCustomerAttributesResponse)
+
+		var customer *Customer
+		customer, err = client.GetCustomer(testCustomerID, "")
+		assert.NoError(t, err)
+		assert.NotNil(t, customer)
+		assert.Equal(t, testCustomerID, customer.ID)
+		assert.Equal(t, testCustomerEmail, customer.Identifiers.Email)
+		assert.Equal(t, "Bob", customer.Attributes["first_name"])
+		assert.Equal(t, int64(1620313799), customer.Timestamps["first_name"])
+		assert.Len(t, customer.Devices, 1)
+		assert.Equal(t, PlatformIOs, customer.Devices[0].Platform)
+	})
+
+	t.Run("successful response (email)", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockGetCustomerResource(http.StatusOK, testCustomerEmail, "attributes", "id_type=email", testCustomerAttributesResponse)
+
+		var customer *Customer
+		customer, err = client.GetCustomer(testCustomerEmail, IDTypeEmail)
+		assert.NoError(t, err)
+		assert.NotNil(t, customer)
+	})
+
+	t.Run("missing customer id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetCustomer("", "")
+		checkParamError(t, err, "customerID")
+	})
+
+	t.Run("invalid id type", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetCustomer(testCustomerID, "phone")
+		checkParamError(t, err, "idType")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockGetCustomerResource(http.StatusNotFound, testCustomerID, "attributes", "", `{"errors":[{"detail":"not found"}]}`)
+
+		var customer *Customer
+		customer, err = client.GetCustomer(testCustomerID, "")
+		assert.Error(t, err)
+		assert.Nil(t, customer)
+	})
+}
+
+// TestClient_GetCustomerDevices will test the method GetCustomerDevices()
+func TestClient_GetCustomerDevices(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockGetCustomerResource(http.StatusOK, testCustomerID, "attributes", "", testCustomerAttributesResponse)
+
+		var devices []*Device
+		devices, err = client.GetCustomerDevices(testCustomerID, IDTypeID)
+		assert.NoError(t, err)
+		assert.Len(t, devices, 1)
+		assert.Equal(t, testDeviceID, devices[0].ID)
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockGetCustomerResource(http.StatusUnauthorized, testCustomerID, "attributes", "", "")
+
+		_, err = client.GetCustomerDevices(testCustomerID, IDTypeID)
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_GetCustomerSegments will test the method GetCustomerSegments()
+func TestClient_GetCustomerSegments(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockGetCustomerResource(http.StatusOK, testCustomerID, "segments", "",
+			`{"segments":[{"id":7,"name":"Paying Customers","type":"dynamic","state":"finished","tags":["billing"]}]}`)
+
+		var segments []*Segment
+		segments, err = client.GetCustomerSegments(testCustomerID, "")
+		assert.NoError(t, err)
+		assert.Len(t, segments, 1)
+		assert.Equal(t, int64(7), segments[0].ID)
+		assert.Equal(t, "Paying Customers", segments[0].Name)
+	})
+
+	t.Run("missing customer id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetCustomerSegments("", "")
+		checkParamError(t, err, "customerID")
+	})
+}
+
+// TestClient_GetCustomerMessages will test the method GetCustomerMessages()
+func TestClient_GetCustomerMessages(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockGetCustomerResource(http.StatusOK, testCustomerID, "messages", "limit=1&start=abc",
+			`{"messages":[{"id":"msg-1","type":"email","recipient":"bob@example.com",`+
+				`"metrics":{"sent":1620313799}}],"next":"def"}`)
+
+		var messages []*Message
+		var next string
+		messages, next, err = client.GetCustomerMessages(testCustomerID, "", &ListOptions{Limit: 1, Start: "abc"})
+		assert.NoError(t, err)
+		assert.Equal(t, "def", next)
+		assert.Len(t, messages, 1)
+		assert.Equal(t, "msg-1", messages[0].ID)
+		assert.Equal(t, int64(1620313799), messages[0].Metrics["sent"])
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockGetCustomerResource(http.StatusInternalServerError, testCustomerID, "messages", "", "")
+
+		_, _, err = client.GetCustomerMessages(testCustomerID, "", nil)
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_GetCustomerActivities will test the method GetCustomerActivities()
+func TestClient_GetCustomerActivities(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockGetCustomerResource(http.StatusOK, testCustomerID, "activities", "name=test_event&type=event",
+			`{"activities":[{"id":"act-1","type":"event","name":"test_event","timestamp":1620313799,`+
+				`"data":{"plan":"basic"}}],"next":""}`)
+
+		var activities []*Activity
+		var next string
+		activities, next, err = client.GetCustomerActivities(testCustomerID, "", &ActivityListOptions{
+			Name: testEventName,
+			Type: "event",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "", next)
+		assert.Len(t, activities, 1)
+		assert.Equal(t, "basic", activities[0].Data["plan"])
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockGetCustomerResource(http.StatusInternalServerError, testCustomerID, "activities", "", "")
+
+		_, _, err = client.GetCustomerActivities(testCustomerID, "", nil)
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_FindCustomersByEmail will test the method FindCustomersByEmail()
+func TestClient_FindCustomersByEmail(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		httpmock.Reset()
+		httpmock.RegisterResponder(http.MethodGet, testAppAPIURL+"v1/customers?email=bob%40example.com",
+			httpmock.NewStringResponder(http.StatusOK,
+				`{"results":[{"email":"bob@example.com","id":"123","cio_id":"a3000001"}]}`),
+		)
+
+		var results []*CustomerIdentifiers
+		results, err = client.FindCustomersByEmail(testCustomerEmail)
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, "a3000001", results[0].CioID)
+	})
+
+	t.Run("missing email", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.FindCustomersByEmail("")
+		checkParamError(t, err, "email")
+	})
+}
+
+// TestClient_SearchCustomers will test the method SearchCustomers()
+func TestClient_SearchCustomers(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSearchCustomers(http.StatusOK)
+
+		var results []*CustomerIdentifiers
+		var next string
+		results, next, err = client.SearchCustomers(&CustomerFilter{
+			And: []*CustomerFilter{
+				{Segment: &SegmentFilter{ID: 7}},
+				{Not: &CustomerFilter{Attribute: &AttributeFilter{Field: "plan", Operator: "eq", Value: "basic"}}},
+			},
+		}, &ListOptions{Limit: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", next)
+		assert.Len(t, results, 2)
+		assert.Equal(t, testCustomerID, results[0].ID)
+	})
+
+	t.Run("missing filter", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, _, err = client.SearchCustomers(nil, nil)
+		checkParamError(t, err, "filter")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSearchCustomers(http.StatusBadRequest)
+
+		_, _, err = client.SearchCustomers(&CustomerFilter{Segment: &SegmentFilter{ID: 7}}, nil)
+		assert.Error(t, err)
+	})
+}
+
+// ExampleClient_GetCustomer example using GetCustomer()
+//
+// See more examples in /examples/
+func ExampleClient_GetCustomer() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockGetCustomerResource(http.StatusOK, testCustomerID, "attributes", "", testCustomerAttributesResponse)
+
+	// Get the customer
+	var customer *Customer
+	if customer, err = client.GetCustomer(testCustomerID, IDTypeID); err != nil {
+		fmt.Printf("error getting customer: %s", err.Error())
+		return
+	}
+	fmt.Printf("customer found: %s", customer.Identifiers.Email)
+	// Output:customer found: bob@example.com
+}
+
+// BenchmarkClient_GetCustomer benchmarks the method GetCustomer()
+func BenchmarkClient_GetCustomer(b *testing.B) {
+	client, _ := newTestClient()
+	mockGetCustomerResource(http.StatusOK, testCustomerID, "attributes", "", testCustomerAttributesResponse)
+	for i := 0; i < b.N; i++ {
+		_, _ = client.GetCustomer(testCustomerID, IDTypeID)
+	}
+}
+
+// testCustomerAttributesResponse is an example response for a customer's attributes
+const testCustomerAttributesResponse = `{"customer":{"id":"123","identifiers":{"id":"123",` +
+	`"email":"bob@example.com","cio_id":"a3000001"},"attributes":{"first_name":"Bob","plan":"basic"},` +
+	`"timestamps":{"first_name":1620313799},"unsubscribed":false,` +
+	`"devices":[{"id":"abcdefghijklmnopqrstuvwxyz","platform":"ios","last_used":1620313799}]}}`
+
+// mockGetCustomerResource is used for mocking the response
+func mockGetCustomerResource(statusCode int, customerID, resource, query, body string) {
+	httpmock.Reset()
+	requestURL := fmt.Sprintf("%sv1/customers/%s/%s", testAppAPIURL, customerID, resource)
+	if query != "" {
+		requestURL += "?" + query
+	}
+	httpmock.RegisterResponder(http.MethodGet, requestURL,
+		httpmock.NewStringResponder(
+			statusCode, body,
+		),
+	)
+}
+
+// mockSearchCustomers is used for mocking the response
+func mockSearchCustomers(statusCode int) {
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%sv1/customers", testAppAPIURL),
+		httpmock.NewStringResponder(
+			statusCode, `{"identifiers":[{"id":"123","email":"bob@example.com","cio_id":"a3000001"},`+
+				`{"id":"456","email":"jane@example.com","cio_id":"a3000002"}],"ids":["123","456"],"next":"abc"}`,
+		),
+	)
+}
@@ -1,6 +1,8 @@
 package customerio
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -79,6 +81,23 @@ func TestClient_UpdateCustomer(t *testing.T) {
 		})
 		assert.NoError(t, err)
 	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockUpdateCustomer(http.StatusOK, testCustomerID)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err = client.UpdateCustomerWithContext(ctx, testCustomerID, map[string]interface{}{
+			"email": testCustomerEmail,
+		})
+		assert.Error(t, err)
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
 }
 
 // ExampleClient_UpdateCustomer example using UpdateCustomer()
@@ -125,6 +144,44 @@ func BenchmarkClient_UpdateCustomer(b *testing.B) {
 	}
 }
 
+// TestClient_IdentifyAnonymous will test the method IdentifyAnonymous()
+func TestClient_IdentifyAnonymous(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockIdentifyAnonymous(http.StatusOK, testCustomerID, testAnonymousID)
+
+		attributes := map[string]interface{}{"email": testCustomerEmail}
+		err = client.IdentifyAnonymous(testCustomerID, testAnonymousID, attributes)
+		assert.NoError(t, err)
+
+		// The given attributes are not modified
+		assert.Len(t, attributes, 1)
+	})
+
+	t.Run("missing anonymous id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.IdentifyAnonymous(testCustomerID, "", nil)
+		checkParamError(t, err, "anonymousID")
+	})
+
+	t.Run("missing customer id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.IdentifyAnonymous("", testAnonymousID, nil)
+		checkParamError(t, err, "customerIDOrEmail")
+	})
+}
+
 // TestClient_DeleteCustomer will test the method DeleteCustomer()
 func TestClient_DeleteCustomer(t *testing.T) {
 	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
@@ -419,6 +476,255 @@ func BenchmarkClient_DeleteDevice(b *testing.B) {
 	}
 }
 
+// TestClient_SuppressCustomer will test the method SuppressCustomer()
+func TestClient_SuppressCustomer(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response (ID)", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSuppressCustomer(http.StatusOK, testCustomerID, "suppress")
+
+		err = client.SuppressCustomer(testCustomerID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("successful response (Email)", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSuppressCustomer(http.StatusOK, testCustomerEmail, "suppress")
+
+		err = client.SuppressCustomer(testCustomerEmail)
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing customer id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.SuppressCustomer("")
+		checkParamError(t, err, "customerIDOrEmail")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSuppressCustomer(http.StatusUnprocessableEntity, testCustomerID, "suppress")
+
+		err = client.SuppressCustomer(testCustomerID)
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_UnsuppressCustomer will test the method UnsuppressCustomer()
+func TestClient_UnsuppressCustomer(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSuppressCustomer(http.StatusOK, testCustomerID, "unsuppress")
+
+		err = client.UnsuppressCustomer(testCustomerID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing customer id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.UnsuppressCustomer("")
+		checkParamError(t, err, "customerIDOrEmail")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSuppressCustomer(http.StatusUnprocessableEntity, testCustomerID, "unsuppress")
+
+		err = client.UnsuppressCustomer(testCustomerID)
+		assert.Error(t, err)
+	})
+}
+
+// ExampleClient_SuppressCustomer example using SuppressCustomer()
+//
+// See more examples in /examples/
+func ExampleClient_SuppressCustomer() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockSuppressCustomer(http.StatusOK, testCustomerID, "suppress")
+
+	// Suppress customer
+	if err = client.SuppressCustomer(testCustomerID); err != nil {
+		fmt.Printf("error suppressing customer: %s", err.Error())
+		return
+	}
+	fmt.Printf("customer suppressed: %s", testCustomerID)
+	// Output:customer suppressed: 123
+}
+
+// BenchmarkClient_SuppressCustomer benchmarks the method SuppressCustomer()
+func BenchmarkClient_SuppressCustomer(b *testing.B) {
+	client, _ := newTestClient()
+	mockSuppressCustomer(http.StatusOK, testCustomerID, "suppress")
+	for i := 0; i < b.N; i++ {
+		_ = client.SuppressCustomer(testCustomerID)
+	}
+}
+
+// TestClient_MergeCustomers will test the method MergeCustomers()
+func TestClient_MergeCustomers(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockMergeCustomers(http.StatusOK)
+
+		err = client.MergeCustomers(IdentifierID(testCustomerID), IdentifierEmail(testCustomerEmail))
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing primary", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockMergeCustomers(http.StatusOK)
+
+		err = client.MergeCustomers(IdentifierID(""), IdentifierEmail(testCustomerEmail))
+		checkParamError(t, err, "primary")
+
+		err = client.MergeCustomers(Identifier{Value: testCustomerID}, IdentifierEmail(testCustomerEmail))
+		checkParamError(t, err, "primary")
+	})
+
+	t.Run("invalid secondary", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockMergeCustomers(http.StatusOK)
+
+		err = client.MergeCustomers(IdentifierID(testCustomerID), Identifier{Type: "phone", Value: "555"})
+		checkParamError(t, err, "secondary")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockMergeCustomers(http.StatusBadRequest)
+
+		err = client.MergeCustomers(IdentifierID(testCustomerID), IdentifierCioID("a3000001"))
+		assert.Error(t, err)
+	})
+}
+
+// ExampleClient_MergeCustomers example using MergeCustomers()
+//
+// See more examples in /examples/
+func ExampleClient_MergeCustomers() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockMergeCustomers(http.StatusOK)
+
+	// Merge the customers
+	if err = client.MergeCustomers(IdentifierID(testCustomerID), IdentifierEmail(testCustomerEmail)); err != nil {
+		fmt.Printf("error merging customers: %s", err.Error())
+		return
+	}
+	fmt.Printf("customers merged into: %s", testCustomerID)
+	// Output:customers merged into: 123
+}
+
+// BenchmarkClient_MergeCustomers benchmarks the method MergeCustomers()
+func BenchmarkClient_MergeCustomers(b *testing.B) {
+	client, _ := newTestClient()
+	mockMergeCustomers(http.StatusOK)
+	for i := 0; i < b.N; i++ {
+		_ = client.MergeCustomers(IdentifierID(testCustomerID), IdentifierEmail(testCustomerEmail))
+	}
+}
+
+// TestIdentifier_MarshalJSON will test the method MarshalJSON()
+func TestIdentifier_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	b, err := json.Marshal(map[string]interface{}{
+		"primary":   IdentifierID(testCustomerID),
+		"secondary": IdentifierCioID("a3000001"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"primary":{"id":"123"},"secondary":{"cio_id":"a3000001"}}`, string(b))
+	assert.Equal(t, map[string]string{"email": testCustomerEmail}, IdentifierEmail(testCustomerEmail).Map())
+}
+
+// mockSuppressCustomer is used for mocking the response (action is suppress or unsuppress)
+func mockSuppressCustomer(statusCode int, customerID, action string) {
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%sapi/v1/customers/%s/%s", testTrackingAPIURL, customerID, action),
+		httpmock.NewStringResponder(
+			statusCode, "",
+		),
+	)
+}
+
+// mockMergeCustomers is used for mocking the response
+func mockMergeCustomers(statusCode int) {
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%sapi/v1/merge_customers", testTrackingAPIURL),
+		httpmock.NewStringResponder(
+			statusCode, "",
+		),
+	)
+}
+
+// mockIdentifyAnonymous is used for mocking the response
+// Responds with a 400 if the request body does not have the expected anonymous id
+func mockIdentifyAnonymous(statusCode int, customerID, anonymousID string) {
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPut, fmt.Sprintf("%sapi/v1/customers/%s", testTrackingAPIURL, customerID),
+		func(req *http.Request) (*http.Response, error) {
+			var body struct {
+				AnonymousID string `json:"anonymous_id"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil || body.AnonymousID != anonymousID {
+				return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+			}
+			return httpmock.NewStringResponse(statusCode, ""), nil
+		},
+	)
+}
+
 // mockUpdateCustomer is used for mocking the response
 func mockUpdateCustomer(statusCode int, customerID string) {
 	httpmock.Reset()
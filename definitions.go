@@ -1,6 +1,8 @@
 package customerio
 
 import (
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -14,6 +16,58 @@ const (
 	version            = "v1.5.0"                    // CustomerIO version
 )
 
+// Defaults for the retry policy
+const (
+	defaultRetryPolicyMaxRetries = 2                      // Default retries for a failed request
+	defaultRetryPolicyMaxWait    = 10 * time.Second       // Default max wait between retries
+	defaultRetryPolicyWait       = 500 * time.Millisecond // Default initial wait between retries
+)
+
+// Defaults for the client-side rate limits (requests per second)
+const (
+	defaultRateLimitApp           = 10  // Default App API limit
+	defaultRateLimitTrack         = 100 // Default Track API limit
+	defaultRateLimitTransactional = 100 // Default Transactional API limit
+)
+
+// Defaults for the background queue
+const (
+	defaultQueueFlushInterval = 5 * time.Second        // Default max time before queued operations are sent
+	defaultQueueFlushSize     = 100                    // Default number of operations that triggers a flush
+	defaultQueueMaxRetries    = 3                      // Default retries for a failed batch request
+	defaultQueueMaxRetryWait  = 10 * time.Second       // Default max wait between retries
+	defaultQueueRetryWait     = 500 * time.Millisecond // Default initial wait between retries
+	defaultQueueSize          = 10000                  // Default max number of buffered operations
+	defaultQueueWorkers       = 2                      // Default number of workers sending batches
+)
+
+// Limits for the Track API v2 batch endpoint
+const (
+	batchEnvelopeSize     = len(`{"batch":[]}`) // Size of the JSON wrapping the batch operations
+	maxBatchOperationSize = 32 * 1024           // Max size of a single operation in bytes
+	maxBatchSize          = 500 * 1024          // Max size of a batch request in bytes
+)
+
+// defaultLogRedactions are the customer attributes redacted from the logged request bodies
+var defaultLogRedactions = []string{"email", "phone"}
+
+// maxSegmentCustomers is the max number of customer ids per manual segment request
+const maxSegmentCustomers = 1000
+
+// maxCollectionSize is the max size of the data in a collection in bytes
+const maxCollectionSize = 10 * 1024 * 1024
+
+// APIFamily is the Customer.io API a request is sent to (each has its own auth and limits)
+type APIFamily string
+
+// Current API families
+const (
+	APIFamilyApp           APIFamily = "app"           // App API
+	APIFamilyBeta          APIFamily = "beta"          // Beta API (uses the App API key and limits)
+	APIFamilyTrack         APIFamily = "track"         // Track API
+	APIFamilyTransactional APIFamily = "transactional" // Transactional API (email, push, sms)
+)
+
 // DevicePlatform is the platform for the customer device
 type DevicePlatform string
 
@@ -31,6 +85,71 @@ func acceptedPlatforms(platform DevicePlatform) bool {
 	return false
 }
 
+// IDType is the type of identifier used to look up a customer
+type IDType string
+
+// Allowed types of identifiers
+const (
+	IDTypeCioID IDType = "cio_id"
+	IDTypeEmail IDType = "email"
+	IDTypeID    IDType = "id"
+)
+
+// acceptedIDTypes will return true if the id type is accepted (empty defaults to id)
+func acceptedIDTypes(idType IDType) bool {
+	switch idType {
+	case "", IDTypeCioID, IDTypeEmail, IDTypeID:
+		return true
+	}
+	return false
+}
+
+// Identifier is a single customer identifier (an id, email or cio_id)
+type Identifier struct {
+	Type  IDType // Type is the type of identifier (id, email or cio_id)
+	Value string // Value is the identifier value
+}
+
+// IdentifierID will return an identifier using the customer id
+func IdentifierID(id string) Identifier {
+	return Identifier{Type: IDTypeID, Value: id}
+}
+
+// IdentifierEmail will return an identifier using the customer email
+func IdentifierEmail(email string) Identifier {
+	return Identifier{Type: IDTypeEmail, Value: email}
+}
+
+// IdentifierCioID will return an identifier using the Customer.io id
+func IdentifierCioID(cioID string) Identifier {
+	return Identifier{Type: IDTypeCioID, Value: cioID}
+}
+
+// customerIdentifier will return the identifier for a customer id or email
+// Values containing an "@" are treated as an email, everything else as an id
+func customerIdentifier(customerIDOrEmail string) Identifier {
+	if strings.Contains(customerIDOrEmail, "@") {
+		return IdentifierEmail(customerIDOrEmail)
+	}
+	return IdentifierID(customerIDOrEmail)
+}
+
+// valid will return true if the identifier has a value and an accepted type
+func (i Identifier) valid() bool {
+	return i.Value != "" && i.Type != "" && acceptedIDTypes(i.Type)
+}
+
+// Map will return the identifier as a map (IE: {"email": "bob@example.com"})
+// Useful for the identifiers used in batch operations and transactional messages
+func (i Identifier) Map() map[string]string {
+	return map[string]string{string(i.Type): i.Value}
+}
+
+// MarshalJSON will marshal the identifier as an object (IE: {"email": "bob@example.com"})
+func (i Identifier) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.Map())
+}
+
 // Device is the customer device model
 type Device struct {
 	ID       string         `json:"id"`
@@ -40,6 +159,7 @@ type Device struct {
 
 // StandardResponse is the standard fields returned on all responses
 type StandardResponse struct {
+	Attempts   int             `json:"-"` // Number of attempts made (visible to middleware, see WithMiddleware())
 	Body       []byte          `json:"-"` // Body of the response request
 	StatusCode int             `json:"-"` // Status code returned on the request
 	Tracing    resty.TraceInfo `json:"-"` // Trace information if enabled on the request
@@ -2,6 +2,7 @@ package customerio
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -81,20 +82,15 @@ func (t *TransactionalResponse) UnmarshalJSON(b []byte) (err error) {
 	return
 }
 
-// TransactionalError is returned if a transactional message fails to send.
-type TransactionalError struct {
-	Err        string // Err is a more specific error message.
-	StatusCode int    // StatusCode is the http status code for the error.
-}
-
-// Error with display the string error message
-func (e *TransactionalError) Error() string {
-	return e.Err
-}
-
 // SendEmail sends a single transactional email using the Customer.io transactional API
 // See: https://customer.io/docs/api/#tag/Transactional
 func (c *Client) SendEmail(emailRequest *EmailRequest) (*EmailResponse, error) {
+	return c.SendEmailWithContext(context.Background(), emailRequest)
+}
+
+// SendEmailWithContext is the same as SendEmail() but uses the given context
+// See: https://customer.io/docs/api/#tag/Transactional
+func (c *Client) SendEmailWithContext(ctx context.Context, emailRequest *EmailRequest) (*EmailResponse, error) {
 
 	// Request cannot be nil, don't panic dude!
 	if emailRequest == nil {
@@ -124,6 +120,8 @@ func (c *Client) SendEmail(emailRequest *EmailRequest) (*EmailResponse, error) {
 
 	// Attempt to send the email
 	response, err := c.request(
+		ctx,
+		"SendEmail",
 		http.MethodPost,
 		fmt.Sprintf("%s/v1/send/email", c.options.apiURL),
 		emailRequest,
@@ -2,15 +2,74 @@ package customerio
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 )
 
+// Errors for the class of a failed API request (use with errors.Is)
+var (
+	ErrNotFound        = errors.New("not found")         // ErrNotFound is a 404 response
+	ErrPayloadTooLarge = errors.New("payload too large") // ErrPayloadTooLarge is a 413 response
+	ErrRateLimited     = errors.New("rate limited")      // ErrRateLimited is a 429 response
+	ErrServer          = errors.New("server error")      // ErrServer is a 5xx response
+	ErrUnauthorized    = errors.New("unauthorized")      // ErrUnauthorized is a 401 or 403 response
+)
+
 // APIError is returned by any method that fails at the API level
+//
+// Use errors.Is() with ErrNotFound, ErrPayloadTooLarge, ErrRateLimited, ErrServer or ErrUnauthorized
+// to branch on the class of the error
 type APIError struct {
-	body   []byte
-	status int
-	url    string
+	body    []byte
+	details []ErrorDetail
+	message string
+	status  int
+	url     string
+}
+
+// ErrorDetail is a single error from the Customer.io error response (errors[])
+type ErrorDetail struct {
+	Detail  string `json:"detail"`  // Detail is the error message (App API)
+	Field   string `json:"field"`   // Field is the invalid field (if any)
+	Message string `json:"message"` // Message is the error message (Track API)
+	Reason  string `json:"reason"`  // Reason is the type of error (if any)
+	Status  string `json:"status"`  // Status is the http status code (if any)
+}
+
+// newAPIError will create an API error and parse the Customer.io JSON error body
+// Supports {"meta":{"error":"..."}} and {"errors":[...]} (objects or strings)
+func newAPIError(status int, requestURL string, body []byte) *APIError {
+	a := &APIError{
+		body:   body,
+		status: status,
+		url:    requestURL,
+	}
+	var resp struct {
+		Errors []json.RawMessage `json:"errors"`
+		Meta   struct {
+			Err string `json:"error"`
+		} `json:"meta"`
+	}
+	if json.Unmarshal(body, &resp) != nil {
+		return a
+	}
+	for _, raw := range resp.Errors {
+		var detail ErrorDetail
+		if json.Unmarshal(raw, &detail.Message) != nil && json.Unmarshal(raw, &detail) != nil {
+			continue
+		}
+		a.details = append(a.details, detail)
+	}
+	a.message = resp.Meta.Err
+	if a.message == "" && len(a.details) > 0 {
+		a.message = a.details[0].Message
+		if a.message == "" {
+			a.message = a.details[0].Detail
+		}
+	}
+	return a
 }
 
 // Error is used to display the error message
@@ -31,6 +90,83 @@ func (a *APIError) Error() string {
 	return fmt.Sprintf("%v: %v %v", a.status, a.url, string(a.body))
 }
 
+// Is will return true if the target is the class of the error (IE: ErrNotFound)
+func (a *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return a.status == http.StatusNotFound
+	case ErrPayloadTooLarge:
+		return a.status == http.StatusRequestEntityTooLarge
+	case ErrRateLimited:
+		return a.status == http.StatusTooManyRequests
+	case ErrServer:
+		return a.status >= http.StatusInternalServerError
+	case ErrUnauthorized:
+		return a.status == http.StatusUnauthorized || a.status == http.StatusForbidden
+	}
+	return false
+}
+
+// Body will return the raw response body
+func (a *APIError) Body() []byte {
+	return a.body
+}
+
+// Details will return the errors parsed from the response body (errors[])
+func (a *APIError) Details() []ErrorDetail {
+	return a.details
+}
+
+// Message will return the error message parsed from the response body (meta.error or the first of errors[])
+func (a *APIError) Message() string {
+	return a.message
+}
+
+// StatusCode will return the http status code of the response
+func (a *APIError) StatusCode() int {
+	return a.status
+}
+
+// URL will return the request URL
+func (a *APIError) URL() string {
+	return a.url
+}
+
+// TransactionalError is returned if a transactional message fails to send.
+//
+// It wraps the APIError, so errors.Is() and errors.As() work the same as other API errors
+type TransactionalError struct {
+	Err        string // Err is a more specific error message.
+	StatusCode int    // StatusCode is the http status code for the error.
+	apiErr     *APIError
+}
+
+// newTransactionalError will create a transactional error from the API error
+func newTransactionalError(apiErr *APIError) *TransactionalError {
+	msg := apiErr.message
+	if !json.Valid(apiErr.body) {
+		msg = string(apiErr.body)
+	}
+	return &TransactionalError{
+		Err:        msg,
+		StatusCode: apiErr.status,
+		apiErr:     apiErr,
+	}
+}
+
+// Error with display the string error message
+func (e *TransactionalError) Error() string {
+	return e.Err
+}
+
+// Unwrap will return the underlying API error
+func (e *TransactionalError) Unwrap() error {
+	if e.apiErr == nil {
+		return nil
+	}
+	return e.apiErr
+}
+
 // ParamError is an error returned if a parameter to the track API is invalid.
 type ParamError struct {
 	Param string // Param is the name of the parameter.
@@ -0,0 +1,150 @@
+package customerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewAPIError will test the method newAPIError()
+func TestNewAPIError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("meta error", func(t *testing.T) {
+		apiErr := newAPIError(http.StatusBadRequest, testTrackingAPIURL, []byte(`{"meta":{"error":"invalid id"}}`))
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+		assert.Equal(t, testTrackingAPIURL, apiErr.URL())
+		assert.Equal(t, `{"meta":{"error":"invalid id"}}`, string(apiErr.Body()))
+		assert.Equal(t, "invalid id", apiErr.Message())
+		assert.Empty(t, apiErr.Details())
+	})
+
+	t.Run("errors (objects)", func(t *testing.T) {
+		apiErr := newAPIError(http.StatusNotFound, testTrackingAPIURL,
+			[]byte(`{"errors":[{"detail":"segment not found","status":"404"}]}`),
+		)
+		assert.Equal(t, "segment not found", apiErr.Message())
+		assert.Equal(t, []ErrorDetail{{Detail: "segment not found", Status: "404"}}, apiErr.Details())
+	})
+
+	t.Run("errors (batch)", func(t *testing.T) {
+		apiErr := newAPIError(http.StatusBadRequest, testTrackingAPIURL,
+			[]byte(`{"errors":[{"reason":"invalid","field":"identifiers","message":"missing id"}]}`),
+		)
+		assert.Equal(t, "missing id", apiErr.Message())
+		assert.Equal(t, "identifiers", apiErr.Details()[0].Field)
+		assert.Equal(t, "invalid", apiErr.Details()[0].Reason)
+	})
+
+	t.Run("errors (strings)", func(t *testing.T) {
+		apiErr := newAPIError(http.StatusBadRequest, testTrackingAPIURL, []byte(`{"errors":["first","second"]}`))
+		assert.Equal(t, "first", apiErr.Message())
+		assert.Len(t, apiErr.Details(), 2)
+	})
+
+	t.Run("not json", func(t *testing.T) {
+		apiErr := newAPIError(http.StatusBadGateway, testTrackingAPIURL, []byte(`bad gateway`))
+		assert.Equal(t, "", apiErr.Message())
+		assert.Empty(t, apiErr.Details())
+		assert.Equal(t, "502: "+testTrackingAPIURL+" bad gateway", apiErr.Error())
+	})
+}
+
+// TestAPIError_Is will test the method Is()
+func TestAPIError_Is(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		status   int
+		expected error
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrUnauthorized},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusRequestEntityTooLarge, ErrPayloadTooLarge},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusInternalServerError, ErrServer},
+		{http.StatusServiceUnavailable, ErrServer},
+	}
+	all := []error{ErrNotFound, ErrPayloadTooLarge, ErrRateLimited, ErrServer, ErrUnauthorized}
+	for _, test := range tests {
+		err := fmt.Errorf("wrapped: %w", newAPIError(test.status, testTrackingAPIURL, nil))
+		for _, sentinel := range all {
+			assert.Equal(t, sentinel == test.expected, errors.Is(err, sentinel), "%d %s", test.status, sentinel)
+		}
+	}
+
+	t.Run("bad request has no class", func(t *testing.T) {
+		err := newAPIError(http.StatusBadRequest, testTrackingAPIURL, nil)
+		for _, sentinel := range all {
+			assert.False(t, errors.Is(err, sentinel))
+		}
+	})
+}
+
+// TestTransactionalError will test the TransactionalError
+func TestTransactionalError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wraps the api error", func(t *testing.T) {
+		err := error(newTransactionalError(
+			newAPIError(http.StatusTooManyRequests, RegionUS.apiURL+"/v1/send/email", []byte(`{"meta":{"error":"slow down"}}`)),
+		))
+		assert.Equal(t, "slow down", err.Error())
+		assert.True(t, errors.Is(err, ErrRateLimited))
+
+		var apiErr *APIError
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode())
+
+		var tErr *TransactionalError
+		assert.True(t, errors.As(err, &tErr))
+		assert.Equal(t, http.StatusTooManyRequests, tErr.StatusCode)
+	})
+
+	t.Run("not json", func(t *testing.T) {
+		err := newTransactionalError(newAPIError(http.StatusBadGateway, RegionUS.apiURL, []byte(`bad gateway`)))
+		assert.Equal(t, "bad gateway", err.Error())
+	})
+
+	t.Run("no api error", func(t *testing.T) {
+		err := &TransactionalError{Err: "failed", StatusCode: http.StatusBadRequest}
+		assert.Nil(t, err.Unwrap())
+		assert.False(t, errors.Is(err, ErrServer))
+	})
+}
+
+// TestClient_requestErrors will test the errors returned by request()
+func TestClient_requestErrors(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	client, err := newTestClient()
+	assert.NoError(t, err)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodGet, testTrackingAPIURL+"missing",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"meta":{"error":"customer not found"}}`),
+	)
+
+	_, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"missing", nil)
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "customer not found", apiErr.Message())
+	assert.Equal(t, testTrackingAPIURL+"missing", apiErr.URL())
+}
+
+// ExampleAPIError_Is example using errors.Is() with an APIError
+func ExampleAPIError_Is() {
+	err := newAPIError(http.StatusTooManyRequests, testTrackingAPIURL, []byte(`{"meta":{"error":"rate limited"}}`))
+	if errors.Is(err, ErrRateLimited) {
+		fmt.Printf("retry later: %s", err.Message())
+	}
+	// Output:retry later: rate limited
+}
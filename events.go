@@ -1,6 +1,7 @@
 package customerio
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +10,15 @@ import (
 	"time"
 )
 
+// EventType is the type of event (regular events do not have a type)
+type EventType string
+
+// Allowed types of events
+const (
+	EventTypePage   EventType = "page"   // A page view (the name is the URL of the page)
+	EventTypeScreen EventType = "screen" // A mobile screen view (the name is the name of the screen)
+)
+
 // NewEvent will create a new event for the supplied customer
 // See: https://customer.io/docs/api/#tag/Track-Events
 // AKA: Track()
@@ -16,57 +26,156 @@ import (
 // Use "timestamp" to send events in the past. If not set, it will use Now().UTC()
 func (c *Client) NewEvent(customerIDOrEmail string, eventName string, timestamp time.Time,
 	data map[string]interface{}) error {
+	return c.NewEventWithContext(context.Background(), customerIDOrEmail, eventName, timestamp, data)
+}
+
+// NewEventWithContext is the same as NewEvent() but uses the given context
+// See: https://customer.io/docs/api/#tag/Track-Events
+func (c *Client) NewEventWithContext(ctx context.Context, customerIDOrEmail string, eventName string,
+	timestamp time.Time, data map[string]interface{}) error {
+	return c.newEvent(ctx, "NewEvent", customerIDOrEmail, eventName, timestamp, data)
+}
+
+// newEvent will create a new event for the customer
+func (c *Client) newEvent(ctx context.Context, operation, customerIDOrEmail, eventName string,
+	timestamp time.Time, data map[string]interface{}) error {
 	if customerIDOrEmail == "" {
 		return ParamError{Param: "customerIDOrEmail"}
 	}
 	if eventName == "" {
 		return ParamError{Param: "eventName"}
 	}
-	if timestamp.IsZero() {
-		timestamp = time.Now().UTC()
-	}
-	if len(data) > 56000 {
-		return errors.New("event body size limited to 56000")
-	}
-
-	_, err := c.request(
-		http.MethodPost,
-		fmt.Sprintf("%s/api/v1/customers/%s/events", c.options.trackURL, url.PathEscape(customerIDOrEmail)),
-		map[string]interface{}{
-			"data":      data,
-			"name":      eventName,
-			"timestamp": timestamp.Unix(),
-			// "type":      "",  (set to Page for a page view) // todo: add support for this feature
-		},
-	)
-	return err
+	return c.sendEvent(ctx, operation, customerIDOrEmail, "", eventName, "", timestamp, data)
 }
 
 // NewAnonymousEvent will create a new event for the anonymous visitor
 // See: https://customer.io/docs/api/#operation/trackAnonymous
 // AKA: TrackAnonymous()
 // Use "timestamp" to send events in the past. If not set, it will use Now().UTC()
+//
+// The event is not associated with an anonymous id, use TrackAnonymousEvent()
+// if the event should be added to the person once they are identified
 func (c *Client) NewAnonymousEvent(eventName string, timestamp time.Time, data map[string]interface{}) error {
+	return c.NewAnonymousEventWithContext(context.Background(), eventName, timestamp, data)
+}
+
+// NewAnonymousEventWithContext is the same as NewAnonymousEvent() but uses the given context
+// See: https://customer.io/docs/api/#operation/trackAnonymous
+func (c *Client) NewAnonymousEventWithContext(ctx context.Context, eventName string, timestamp time.Time,
+	data map[string]interface{}) error {
 	if eventName == "" {
 		return ParamError{Param: "eventName"}
 	}
-	if timestamp.IsZero() {
-		timestamp = time.Now().UTC()
+	return c.sendEvent(ctx, "NewAnonymousEvent", "", "", eventName, "", timestamp, data)
+}
+
+// TrackAnonymousEvent will create a new event for the anonymous visitor using their anonymous id
+// When the person is identified using the same anonymous id (see: IdentifyAnonymous()),
+// Customer.io will add the anonymous events to the person's activity
+// See: https://customer.io/docs/api/track/#operation/trackAnonymous
+// Use "timestamp" to send events in the past. If not set, it will use Now().UTC()
+func (c *Client) TrackAnonymousEvent(anonymousID string, eventName string, timestamp time.Time,
+	data map[string]interface{}) error {
+	return c.TrackAnonymousEventWithContext(context.Background(), anonymousID, eventName, timestamp, data)
+}
+
+// TrackAnonymousEventWithContext is the same as TrackAnonymousEvent() but uses the given context
+// See: https://customer.io/docs/api/track/#operation/trackAnonymous
+func (c *Client) TrackAnonymousEventWithContext(ctx context.Context, anonymousID string, eventName string,
+	timestamp time.Time, data map[string]interface{}) error {
+	if anonymousID == "" {
+		return ParamError{Param: "anonymousID"}
 	}
-	if len(data) > 56000 {
-		return errors.New("event body size limited to 56000")
+	if eventName == "" {
+		return ParamError{Param: "eventName"}
 	}
-	_, err := c.request(
-		http.MethodPost,
-		fmt.Sprintf("%s/api/v1/events", c.options.trackURL),
-		map[string]interface{}{
-			"data":      data,
-			"name":      eventName,
-			"timestamp": timestamp.Unix(),
-			// "type":      "",  (set to Page for a page view) // todo: add support for this feature
-		},
-	)
-	return err
+	return c.sendEvent(ctx, "TrackAnonymousEvent", "", anonymousID, eventName, "", timestamp, data)
+}
+
+// TrackPageView will create a page view event for the supplied customer
+// See: https://customer.io/docs/api/track/#operation/track
+// Only use "email" if the workspace is setup to use email instead of ID
+// Use "timestamp" to send events in the past. If not set, it will use Now().UTC()
+func (c *Client) TrackPageView(customerIDOrEmail string, pageURL string, timestamp time.Time,
+	data map[string]interface{}) error {
+	return c.TrackPageViewWithContext(context.Background(), customerIDOrEmail, pageURL, timestamp, data)
+}
+
+// TrackPageViewWithContext is the same as TrackPageView() but uses the given context
+// See: https://customer.io/docs/api/track/#operation/track
+func (c *Client) TrackPageViewWithContext(ctx context.Context, customerIDOrEmail string, pageURL string,
+	timestamp time.Time, data map[string]interface{}) error {
+	if customerIDOrEmail == "" {
+		return ParamError{Param: "customerIDOrEmail"}
+	}
+	if pageURL == "" {
+		return ParamError{Param: "pageURL"}
+	}
+	return c.sendEvent(ctx, "TrackPageView", customerIDOrEmail, "", pageURL, EventTypePage, timestamp, data)
+}
+
+// TrackScreenView will create a mobile screen view event for the supplied customer
+// See: https://customer.io/docs/api/track/#operation/track
+// Only use "email" if the workspace is setup to use email instead of ID
+// Use "timestamp" to send events in the past. If not set, it will use Now().UTC()
+func (c *Client) TrackScreenView(customerIDOrEmail string, screenName string, timestamp time.Time,
+	data map[string]interface{}) error {
+	return c.TrackScreenViewWithContext(context.Background(), customerIDOrEmail, screenName, timestamp, data)
+}
+
+// TrackScreenViewWithContext is the same as TrackScreenView() but uses the given context
+// See: https://customer.io/docs/api/track/#operation/track
+func (c *Client) TrackScreenViewWithContext(ctx context.Context, customerIDOrEmail string, screenName string,
+	timestamp time.Time, data map[string]interface{}) error {
+	if customerIDOrEmail == "" {
+		return ParamError{Param: "customerIDOrEmail"}
+	}
+	if screenName == "" {
+		return ParamError{Param: "screenName"}
+	}
+	return c.sendEvent(ctx, "TrackScreenView", customerIDOrEmail, "", screenName, EventTypeScreen, timestamp, data)
+}
+
+// TrackAnonymousPageView will create a page view event for the anonymous visitor
+// See: https://customer.io/docs/api/track/#operation/trackAnonymous
+// Use "timestamp" to send events in the past. If not set, it will use Now().UTC()
+func (c *Client) TrackAnonymousPageView(anonymousID string, pageURL string, timestamp time.Time,
+	data map[string]interface{}) error {
+	return c.TrackAnonymousPageViewWithContext(context.Background(), anonymousID, pageURL, timestamp, data)
+}
+
+// TrackAnonymousPageViewWithContext is the same as TrackAnonymousPageView() but uses the given context
+// See: https://customer.io/docs/api/track/#operation/trackAnonymous
+func (c *Client) TrackAnonymousPageViewWithContext(ctx context.Context, anonymousID string, pageURL string,
+	timestamp time.Time, data map[string]interface{}) error {
+	if anonymousID == "" {
+		return ParamError{Param: "anonymousID"}
+	}
+	if pageURL == "" {
+		return ParamError{Param: "pageURL"}
+	}
+	return c.sendEvent(ctx, "TrackAnonymousPageView", "", anonymousID, pageURL, EventTypePage, timestamp, data)
+}
+
+// TrackAnonymousScreenView will create a mobile screen view event for the anonymous visitor
+// See: https://customer.io/docs/api/track/#operation/trackAnonymous
+// Use "timestamp" to send events in the past. If not set, it will use Now().UTC()
+func (c *Client) TrackAnonymousScreenView(anonymousID string, screenName string, timestamp time.Time,
+	data map[string]interface{}) error {
+	return c.TrackAnonymousScreenViewWithContext(context.Background(), anonymousID, screenName, timestamp, data)
+}
+
+// TrackAnonymousScreenViewWithContext is the same as TrackAnonymousScreenView() but uses the given context
+// See: https://customer.io/docs/api/track/#operation/trackAnonymous
+func (c *Client) TrackAnonymousScreenViewWithContext(ctx context.Context, anonymousID string, screenName string,
+	timestamp time.Time, data map[string]interface{}) error {
+	if anonymousID == "" {
+		return ParamError{Param: "anonymousID"}
+	}
+	if screenName == "" {
+		return ParamError{Param: "screenName"}
+	}
+	return c.sendEvent(ctx, "TrackAnonymousScreenView", "", anonymousID, screenName, EventTypeScreen, timestamp, data)
 }
 
 // NewEventUsingInterface is a wrapper for NewEvent() which can take a custom struct vs map[string]interface{}
@@ -76,6 +185,13 @@ func (c *Client) NewAnonymousEvent(eventName string, timestamp time.Time, data m
 // Use "timestamp" to send events in the past. If not set, it will use Now().UTC()
 func (c *Client) NewEventUsingInterface(customerIDOrEmail string, eventName string, timestamp time.Time,
 	data interface{}) error {
+	return c.NewEventUsingInterfaceWithContext(context.Background(), customerIDOrEmail, eventName, timestamp, data)
+}
+
+// NewEventUsingInterfaceWithContext is the same as NewEventUsingInterface() but uses the given context
+// See: https://customer.io/docs/api/#tag/Track-Events
+func (c *Client) NewEventUsingInterfaceWithContext(ctx context.Context, customerIDOrEmail string,
+	eventName string, timestamp time.Time, data interface{}) error {
 
 	// Marshall struct into JSON string
 	var mapInterface map[string]interface{}
@@ -90,5 +206,39 @@ func (c *Client) NewEventUsingInterface(customerIDOrEmail string, eventName stri
 	}
 
 	// Fire main method
-	return c.NewEvent(customerIDOrEmail, eventName, timestamp, mapInterface)
+	return c.newEvent(ctx, "NewEventUsingInterface", customerIDOrEmail, eventName, timestamp, mapInterface)
+}
+
+// sendEvent will send the event for the customer, or as an anonymous event if the customerIDOrEmail is empty
+// The anonymousID and eventType are optional
+func (c *Client) sendEvent(ctx context.Context, operation, customerIDOrEmail, anonymousID, eventName string,
+	eventType EventType, timestamp time.Time, data map[string]interface{}) error {
+	if timestamp.IsZero() {
+		timestamp = time.Now().UTC()
+	}
+	if len(data) > 56000 {
+		return errors.New("event body size limited to 56000")
+	}
+
+	body := map[string]interface{}{
+		"data":      data,
+		"name":      eventName,
+		"timestamp": timestamp.Unix(),
+	}
+	if eventType != "" {
+		body["type"] = eventType
+	}
+	if anonymousID != "" {
+		body["anonymous_id"] = anonymousID
+	}
+
+	requestURL := fmt.Sprintf("%s/api/v1/events", c.options.trackURL)
+	if customerIDOrEmail != "" {
+		requestURL = fmt.Sprintf(
+			"%s/api/v1/customers/%s/events", c.options.trackURL, url.PathEscape(customerIDOrEmail),
+		)
+	}
+
+	_, err := c.request(ctx, operation, http.MethodPost, requestURL, body)
+	return err
 }
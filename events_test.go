@@ -1,6 +1,7 @@
 package customerio
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -388,6 +389,245 @@ func TestClient_NewEventUsingInterface(t *testing.T) {
 	})
 }
 
+// TestClient_TrackAnonymousEvent will test the method TrackAnonymousEvent()
+func TestClient_TrackAnonymousEvent(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockTrackView(http.StatusOK, "api/v1/events", "", testEventName, testAnonymousID)
+
+		err = client.TrackAnonymousEvent(testAnonymousID, testEventName, time.Time{}, map[string]interface{}{
+			"plan": "basic",
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing anonymous id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.TrackAnonymousEvent("", testEventName, time.Time{}, nil)
+		checkParamError(t, err, "anonymousID")
+	})
+
+	t.Run("missing event name", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.TrackAnonymousEvent(testAnonymousID, "", time.Time{}, nil)
+		checkParamError(t, err, "eventName")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockTrackView(http.StatusUnauthorized, "api/v1/events", "", testEventName, testAnonymousID)
+
+		err = client.TrackAnonymousEvent(testAnonymousID, testEventName, time.Time{}, nil)
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_TrackPageView will test the method TrackPageView()
+func TestClient_TrackPageView(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockTrackView(http.StatusOK, "api/v1/customers/"+testCustomerID+"/events", EventTypePage, testPageURL, "")
+
+		err = client.TrackPageView(testCustomerID, testPageURL, time.Time{}, map[string]interface{}{
+			"referrer": "https://google.com",
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing customer id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.TrackPageView("", testPageURL, time.Time{}, nil)
+		checkParamError(t, err, "customerIDOrEmail")
+	})
+
+	t.Run("missing page url", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.TrackPageView(testCustomerID, "", time.Time{}, nil)
+		checkParamError(t, err, "pageURL")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockTrackView(http.StatusUnprocessableEntity, "api/v1/customers/"+testCustomerID+"/events", EventTypePage, testPageURL, "")
+
+		err = client.TrackPageView(testCustomerID, testPageURL, time.Time{}, nil)
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_TrackScreenView will test the method TrackScreenView()
+func TestClient_TrackScreenView(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockTrackView(http.StatusOK, "api/v1/customers/"+testCustomerID+"/events", EventTypeScreen, testScreenName, "")
+
+		err = client.TrackScreenView(testCustomerID, testScreenName, time.Now().UTC(), nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing screen name", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.TrackScreenView(testCustomerID, "", time.Time{}, nil)
+		checkParamError(t, err, "screenName")
+	})
+}
+
+// TestClient_TrackAnonymousPageView will test the method TrackAnonymousPageView()
+func TestClient_TrackAnonymousPageView(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockTrackView(http.StatusOK, "api/v1/events", EventTypePage, testPageURL, testAnonymousID)
+
+		err = client.TrackAnonymousPageView(testAnonymousID, testPageURL, time.Time{}, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing anonymous id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.TrackAnonymousPageView("", testPageURL, time.Time{}, nil)
+		checkParamError(t, err, "anonymousID")
+	})
+
+	t.Run("missing page url", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.TrackAnonymousPageView(testAnonymousID, "", time.Time{}, nil)
+		checkParamError(t, err, "pageURL")
+	})
+}
+
+// TestClient_TrackAnonymousScreenView will test the method TrackAnonymousScreenView()
+func TestClient_TrackAnonymousScreenView(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockTrackView(http.StatusOK, "api/v1/events", EventTypeScreen, testScreenName, testAnonymousID)
+
+		err = client.TrackAnonymousScreenView(testAnonymousID, testScreenName, time.Time{}, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing anonymous id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.TrackAnonymousScreenView("", testScreenName, time.Time{}, nil)
+		checkParamError(t, err, "anonymousID")
+	})
+
+	t.Run("missing screen name", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.TrackAnonymousScreenView(testAnonymousID, "", time.Time{}, nil)
+		checkParamError(t, err, "screenName")
+	})
+}
+
+// ExampleClient_TrackPageView example using TrackPageView()
+//
+// See more examples in /examples/
+func ExampleClient_TrackPageView() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockTrackView(http.StatusOK, "api/v1/customers/"+testCustomerID+"/events", EventTypePage, testPageURL, "")
+
+	// Track a page view
+	if err = client.TrackPageView(testCustomerID, testPageURL, time.Now().UTC(), nil); err != nil {
+		fmt.Printf("error tracking page view: %s", err.Error())
+		return
+	}
+	fmt.Printf("page view tracked: %s", testPageURL)
+	// Output:page view tracked: https://example.com/pricing
+}
+
+// BenchmarkClient_TrackPageView benchmarks the method TrackPageView()
+func BenchmarkClient_TrackPageView(b *testing.B) {
+	client, _ := newTestClient()
+	mockTrackView(http.StatusOK, "api/v1/customers/"+testCustomerID+"/events", EventTypePage, testPageURL, "")
+	for i := 0; i < b.N; i++ {
+		_ = client.TrackPageView(testCustomerID, testPageURL, time.Now().UTC(), nil)
+	}
+}
+
+// mockTrackView is used for mocking the response
+// Responds with a 400 if the request body does not have the expected type, name or anonymous id
+func mockTrackView(statusCode int, path string, eventType EventType, name, anonymousID string) {
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost, testTrackingAPIURL+path,
+		func(req *http.Request) (*http.Response, error) {
+			var body struct {
+				AnonymousID string    `json:"anonymous_id"`
+				Name        string    `json:"name"`
+				Type        EventType `json:"type"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil ||
+				body.Type != eventType || body.Name != name || body.AnonymousID != anonymousID {
+				return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+			}
+			return httpmock.NewStringResponse(statusCode, ""), nil
+		},
+	)
+}
+
 // mockNewEvent is used for mocking the response
 func mockNewEvent(statusCode int, customerID string) {
 	httpmock.Reset()
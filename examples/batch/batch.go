@@ -0,0 +1,49 @@
+package main
+
+import (
+	"log"
+	"os"
+	"time"
+
+	"github.com/mrz1836/go-customerio"
+)
+
+func main() {
+
+	// Load the client (with Tracking API enabled)
+	client, err := customerio.NewClient(
+		customerio.WithTrackingKey(os.Getenv("TRACKING_SITE_ID"), os.Getenv("TRACKING_API_KEY")),
+	)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// Start a new batch
+	batch := client.NewBatch()
+	if err = batch.Identify(
+		map[string]string{"id": "123"},
+		map[string]interface{}{
+			"email":      "bob@example.com",
+			"first_name": "Bob",
+		}); err != nil {
+		log.Fatalln(err)
+	}
+	if err = batch.Track(
+		map[string]string{"id": "123"}, "order_completed", time.Now().UTC(),
+		map[string]interface{}{
+			"order_id": "1234567",
+			"amount":   "99.99",
+		}); err != nil {
+		log.Fatalln(err)
+	}
+
+	// Send the batch (split into multiple requests if needed)
+	var result *customerio.BatchResult
+	if result, err = batch.Send(); err != nil {
+		log.Fatalln(err)
+	}
+	for _, failed := range result.Failed {
+		log.Println(failed.Error())
+	}
+	log.Printf("Batch Sent Successfully! (%d operations)", result.Succeeded)
+}
@@ -18,8 +18,8 @@ func main() {
 		log.Fatalln(err)
 	}
 
-	err = client.UpdateCollection(
-		"",
+	var collection *customerio.Collection
+	collection, err = client.CreateCollection(
 		"test_collection",
 		[]map[string]interface{}{
 			{
@@ -37,5 +37,5 @@ func main() {
 		log.Fatalln(err.Error())
 	}
 
-	log.Println("Collection Added Successfully!")
+	log.Printf("Collection Added Successfully! (id: %d)", collection.ID)
 }
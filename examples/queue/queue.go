@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/mrz1836/go-customerio"
+)
+
+func main() {
+
+	// Load the client (with Tracking API enabled)
+	client, err := customerio.NewClient(
+		customerio.WithTrackingKey(os.Getenv("TRACKING_SITE_ID"), os.Getenv("TRACKING_API_KEY")),
+	)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// Start the background queue
+	queue := client.NewQueue(
+		customerio.WithFlushInterval(time.Second),
+		customerio.WithOnFailed(func(failed *customerio.BatchItemError) {
+			log.Println(failed.Error())
+		}),
+	)
+
+	// Queue an event (does not block)
+	if err = queue.NewEvent(
+		"123", "order_completed", time.Now().UTC(),
+		map[string]interface{}{
+			"order_id": "1234567",
+			"amount":   "99.99",
+		}); err != nil {
+		log.Fatalln(err)
+	}
+
+	// Send any remaining events before exiting
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err = queue.Close(ctx); err != nil {
+		log.Fatalln(err)
+	}
+	log.Println("Queue Closed Successfully!")
+}
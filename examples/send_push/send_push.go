@@ -0,0 +1,32 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/mrz1836/go-customerio"
+)
+
+func main() {
+
+	// Load the client (with App API enabled)
+	client, err := customerio.NewClient(
+		customerio.WithAppKey(os.Getenv("APP_API_KEY")),
+	)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// Send a push (using a template)
+	if _, err = client.SendPush(&customerio.PushRequest{
+		Identifiers: map[string]string{"id": "123"},
+		MessageData: map[string]interface{}{
+			"order_id": "1234567",
+		},
+		Title:                  "Your order shipped!",
+		TransactionalMessageID: "3",
+	}); err != nil {
+		log.Fatalln(err)
+	}
+	log.Println("Push Sent Successfully!")
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/mrz1836/go-customerio"
+)
+
+func main() {
+
+	// Load the client (with App API enabled)
+	client, err := customerio.NewClient(
+		customerio.WithAppKey(os.Getenv("APP_API_KEY")),
+	)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// Send an SMS (using a template)
+	if _, err = client.SendSMS(&customerio.SMSRequest{
+		Identifiers: map[string]string{"id": "123"},
+		MessageData: map[string]interface{}{
+			"code": "123456",
+		},
+		TransactionalMessageID: "4",
+	}); err != nil {
+		log.Fatalln(err)
+	}
+	log.Println("SMS Sent Successfully!")
+}
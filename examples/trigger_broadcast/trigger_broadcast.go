@@ -0,0 +1,38 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/mrz1836/go-customerio"
+)
+
+func main() {
+
+	// Load the client (with App API enabled)
+	client, err := customerio.NewClient(
+		customerio.WithAppKey(os.Getenv("APP_API_KEY")),
+	)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// Trigger the broadcast for a list of customers
+	var triggerID int64
+	if triggerID, err = client.TriggerBroadcast(12, &customerio.BroadcastTrigger{
+		Data: map[string]interface{}{
+			"headline": "Our biggest sale of the year",
+		},
+		IDs:             []string{"123", "456"},
+		IDIgnoreMissing: true,
+	}); err != nil {
+		log.Fatalln(err)
+	}
+
+	// Check the status of the trigger
+	var status *customerio.BroadcastTriggerStatus
+	if status, err = client.GetBroadcastTriggerStatus(12, triggerID); err != nil {
+		log.Fatalln(err)
+	}
+	log.Printf("Broadcast Triggered! (trigger: %d processed: %t)", triggerID, status.Processed)
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/mrz1836/go-customerio/webhooks"
+)
+
+func main() {
+
+	// Load the handler (with the webhook signing key)
+	handler := webhooks.NewHandler(
+		os.Getenv("WEBHOOK_SIGNING_KEY"),
+		webhooks.WithErrorHandler(func(_ *http.Request, err error) {
+			log.Println(err)
+		}),
+	)
+
+	// Register the callbacks
+	handler.OnEmail(func(_ context.Context, e *webhooks.EmailEvent) error {
+		if e.Metric == webhooks.MetricBounced {
+			log.Printf("Email Bounced: %s", e.Data.Recipient)
+		}
+		return nil
+	})
+
+	// Start the server
+	http.Handle("/webhooks/customerio", handler)
+	log.Fatalln(http.ListenAndServe(":8080", nil)) //nolint:gosec // example only
+}
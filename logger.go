@@ -0,0 +1,153 @@
+package customerio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// redacted replaces any sensitive value in the logs
+const redacted = "[REDACTED]"
+
+// logRequest will log the request (debug) or the failure (warn or error)
+func (c *Client) logRequest(ctx context.Context, req *Request, response StandardResponse, err error,
+	elapsed time.Duration) {
+
+	logger := c.options.logger
+	if logger == nil {
+		return
+	}
+
+	// Pick the level by the class of the error
+	level := slog.LevelDebug
+	switch {
+	case err == nil:
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		level = slog.LevelWarn
+	case response.StatusCode > 0 && response.StatusCode < http.StatusInternalServerError:
+		level = slog.LevelWarn
+	default: // Server or transport errors
+		level = slog.LevelError
+	}
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("operation", req.Operation),
+		slog.String("method", req.Method),
+		slog.String("url", c.redactURL(req.URL)),
+		slog.String("family", string(req.Family)),
+		slog.Int("status", response.StatusCode),
+		slog.Duration("duration", elapsed),
+		slog.Int("attempts", response.Attempts),
+	}
+	if len(req.Body) > 0 && logger.Enabled(ctx, slog.LevelDebug) {
+		attrs = append(attrs, slog.String("body", c.redactBody(req.Body)))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", c.redactError(err)))
+		logger.LogAttrs(ctx, level, "customerio request failed", attrs...)
+		return
+	}
+	logger.LogAttrs(ctx, level, "customerio request", attrs...)
+}
+
+// redact will remove the API keys and the authorization from the string
+func (c *Client) redact(s string) string {
+	secrets := make([]string, 0, 6)
+	for _, secret := range []string{c.options.appAPIKey, c.options.trackingAPIKey} {
+		if secret != "" {
+			secrets = append(secrets, secret, redacted)
+		}
+	}
+	if c.options.trackingAPIKey != "" {
+		secrets = append(secrets, c.auth(), redacted)
+	}
+	if len(secrets) == 0 {
+		return s
+	}
+	return strings.NewReplacer(secrets...).Replace(s)
+}
+
+// redactError will remove the secrets and the customer data (URL and response body) from the error
+func (c *Client) redactError(err error) string {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return strings.TrimSpace(
+			fmt.Sprintf("%d: %s %s", apiErr.status, c.redactURL(apiErr.url), c.redactBody(apiErr.body)),
+		)
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return fmt.Sprintf("%s %q: %s", urlErr.Op, c.redactURL(urlErr.URL), c.redact(urlErr.Err.Error()))
+	}
+	return c.redact(err.Error())
+}
+
+// redactURL will remove the secrets, the query values and any email address in the path
+func (c *Client) redactURL(requestURL string) string {
+	u, err := url.Parse(c.redact(requestURL))
+	if err != nil {
+		return redacted
+	}
+	segments := strings.Split(u.EscapedPath(), "/")
+	for i, segment := range segments {
+		if strings.Contains(segment, "@") || strings.Contains(strings.ToLower(segment), "%40") {
+			segments[i] = redacted
+		}
+	}
+	redactedURL := u.Scheme + "://" + u.Host + strings.Join(segments, "/")
+	if u.RawQuery == "" {
+		return redactedURL
+	}
+	keys := make([]string, 0)
+	for key := range u.Query() {
+		keys = append(keys, key+"="+redacted)
+	}
+	sort.Strings(keys)
+	return redactedURL + "?" + strings.Join(keys, "&")
+}
+
+// redactBody will remove the secrets and the redacted customer attributes from the JSON body
+func (c *Client) redactBody(body []byte) string {
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return c.redact(string(body))
+	}
+	keys := make(map[string]bool, len(c.options.logRedactions))
+	for _, key := range c.options.logRedactions {
+		keys[strings.ToLower(key)] = true
+	}
+	b, err := json.Marshal(redactValue(data, keys))
+	if err != nil {
+		return redacted
+	}
+	return c.redact(string(b))
+}
+
+// redactValue will replace the values of the redacted keys (at any depth)
+func redactValue(value interface{}, keys map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if keys[strings.ToLower(key)] {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(val, keys)
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactValue(val, keys)
+		}
+	}
+	return value
+}
@@ -0,0 +1,229 @@
+package customerio
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestLoggerClient will return a test client logging (JSON) into the buffer
+func newTestLoggerClient(level slog.Level, opts ...ClientOps) (*Client, *bytes.Buffer, error) {
+	client, err := newTestClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	buf := new(bytes.Buffer)
+	WithLogger(slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: level})))(client.options)
+	client.options.appAPIKey = testAppAPIKey
+	for _, opt := range opts {
+		opt(client.options)
+	}
+	return client, buf, nil
+}
+
+// logLines will return the JSON log lines in the buffer
+func logLines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var lines []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var m map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(line), &m))
+		lines = append(lines, m)
+	}
+	return lines
+}
+
+// TestWithLogger will test the method WithLogger()
+func TestWithLogger(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default is no logger", func(t *testing.T) {
+		client, err := NewClient(WithTrackingKey(testSiteID, testTrackingAPIKey))
+		assert.NoError(t, err)
+		assert.Nil(t, client.options.logger)
+		assert.Equal(t, defaultLogRedactions, client.options.logRedactions)
+	})
+
+	t.Run("custom logger and redaction", func(t *testing.T) {
+		client, err := NewClient(
+			WithTrackingKey(testSiteID, testTrackingAPIKey),
+			WithLogger(slog.Default()),
+			WithLogRedaction("first_name"),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, slog.Default(), client.options.logger)
+		assert.Equal(t, []string{"first_name"}, client.options.logRedactions)
+	})
+}
+
+// TestClient_logRequest will test the method logRequest()
+func TestClient_logRequest(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("debug request", func(t *testing.T) {
+		client, buf, err := newTestLoggerClient(slog.LevelDebug)
+		assert.NoError(t, err)
+
+		httpmock.Reset()
+		httpmock.RegisterResponder(http.MethodPut, testTrackingAPIURL+"api/v1/customers/test%40example.com",
+			httpmock.NewStringResponder(http.StatusOK, ``),
+		)
+
+		_, err = client.request(context.Background(), "Test", http.MethodPut,
+			testTrackingAPIURL+"api/v1/customers/test%40example.com?id_type=email",
+			map[string]interface{}{"email": "test@example.com", "plan": "premium"},
+		)
+		assert.NoError(t, err)
+
+		lines := logLines(t, buf)
+		assert.Len(t, lines, 1)
+		assert.Equal(t, "DEBUG", lines[0]["level"])
+		assert.Equal(t, "customerio request", lines[0]["msg"])
+		assert.Equal(t, http.MethodPut, lines[0]["method"])
+		assert.Equal(t, testTrackingAPIURL+"api/v1/customers/[REDACTED]?id_type=[REDACTED]", lines[0]["url"])
+		assert.Equal(t, "track", lines[0]["family"])
+		assert.Equal(t, float64(http.StatusOK), lines[0]["status"])
+		assert.Equal(t, float64(1), lines[0]["attempts"])
+		assert.Equal(t, `{"email":"[REDACTED]","plan":"premium"}`, lines[0]["body"])
+		assert.Contains(t, lines[0], "duration")
+	})
+
+	t.Run("client error is a warning", func(t *testing.T) {
+		client, buf, err := newTestLoggerClient(slog.LevelWarn)
+		assert.NoError(t, err)
+
+		mockTestAuth(http.StatusUnauthorized)
+
+		_, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"auth", nil)
+		assert.Error(t, err)
+
+		lines := logLines(t, buf)
+		assert.Len(t, lines, 1)
+		assert.Equal(t, "WARN", lines[0]["level"])
+		assert.Equal(t, "customerio request failed", lines[0]["msg"])
+		assert.Contains(t, lines[0]["error"], "401")
+	})
+
+	t.Run("server error is an error", func(t *testing.T) {
+		client, buf, err := newTestLoggerClient(slog.LevelWarn)
+		assert.NoError(t, err)
+
+		mockTestAuth(http.StatusInternalServerError)
+
+		_, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"auth", nil)
+		assert.Error(t, err)
+
+		lines := logLines(t, buf)
+		assert.Len(t, lines, 1)
+		assert.Equal(t, "ERROR", lines[0]["level"])
+	})
+
+	t.Run("debug is not logged above the level", func(t *testing.T) {
+		client, buf, err := newTestLoggerClient(slog.LevelInfo)
+		assert.NoError(t, err)
+
+		mockTestAuth(http.StatusOK)
+
+		_, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"auth", nil)
+		assert.NoError(t, err)
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("secrets are redacted from errors", func(t *testing.T) {
+		client, buf, err := newTestLoggerClient(slog.LevelDebug)
+		assert.NoError(t, err)
+
+		httpmock.Reset()
+		httpmock.RegisterResponder(http.MethodGet, testTrackingAPIURL+"auth",
+			httpmock.NewStringResponder(http.StatusUnauthorized,
+				`{"meta":{"error":"bad key `+testTrackingAPIKey+` `+client.auth()+`"}}`),
+		)
+
+		_, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"auth", nil)
+		assert.Error(t, err)
+		assert.NotContains(t, buf.String(), testTrackingAPIKey)
+		assert.NotContains(t, buf.String(), client.auth())
+		assert.Contains(t, buf.String(), redacted)
+	})
+
+	t.Run("customer email is redacted from errors", func(t *testing.T) {
+		client, buf, err := newTestLoggerClient(slog.LevelDebug)
+		assert.NoError(t, err)
+
+		httpmock.Reset()
+		httpmock.RegisterResponder(http.MethodGet, testAppAPIURL+"v1/customers/test@example.com/attributes?id_type=email",
+			httpmock.NewStringResponder(http.StatusNotFound, `{"errors":[{"detail":"not found","status":"404"}]}`),
+		)
+		httpmock.RegisterResponder(http.MethodGet, testAppAPIURL+"v1/customers?email=test%40example.com",
+			httpmock.NewErrorResponder(errors.New("connection reset")),
+		)
+
+		_, err = client.GetCustomer("test@example.com", IDTypeEmail)
+		assert.True(t, errors.Is(err, ErrNotFound))
+		_, err = client.FindCustomersByEmail("test@example.com")
+		assert.Error(t, err)
+
+		lines := logLines(t, buf)
+		assert.Len(t, lines, 2)
+		assert.Equal(t, "404: "+testAppAPIURL+"v1/customers/[REDACTED]/attributes?id_type=[REDACTED] "+
+			`{"errors":[{"detail":"not found","status":"404"}]}`, lines[0]["error"])
+		assert.Contains(t, lines[1]["error"], "connection reset")
+		assert.NotContains(t, buf.String(), "test@example.com")
+		assert.NotContains(t, buf.String(), "test%40example.com")
+	})
+
+	t.Run("duration is the elapsed time", func(t *testing.T) {
+		client, buf, err := newTestLoggerClient(slog.LevelDebug)
+		assert.NoError(t, err)
+
+		response := StandardResponse{StatusCode: http.StatusOK}
+		response.Tracing.TotalTime = time.Duration(math.MaxInt64)
+		client.logRequest(context.Background(), &Request{URL: testTrackingAPIURL + "auth"}, response, nil,
+			5*time.Millisecond,
+		)
+
+		lines := logLines(t, buf)
+		assert.Len(t, lines, 1)
+		assert.Equal(t, float64(5*time.Millisecond), lines[0]["duration"])
+	})
+}
+
+// TestClient_redact will test the redaction methods
+func TestClient_redact(t *testing.T) {
+	t.Parallel()
+
+	client, err := NewClient(WithTrackingKey(testSiteID, testTrackingAPIKey), WithAppKey(testAppAPIKey))
+	assert.NoError(t, err)
+
+	t.Run("redact", func(t *testing.T) {
+		assert.Equal(t, "key [REDACTED] [REDACTED]", client.redact("key "+testAppAPIKey+" "+testTrackingAPIKey))
+		assert.Equal(t, "Basic [REDACTED]", client.redact("Basic "+client.auth()))
+		assert.Equal(t, "nothing to redact", client.redact("nothing to redact"))
+	})
+
+	t.Run("redactURL", func(t *testing.T) {
+		assert.Equal(t, RegionUS.apiURL+"/v1/segments/1", client.redactURL(RegionUS.apiURL+"/v1/segments/1"))
+		assert.Equal(t, RegionUS.apiURL+"/v1/customers/[REDACTED]/attributes",
+			client.redactURL(RegionUS.apiURL+"/v1/customers/a@b.com/attributes"))
+		assert.Equal(t, RegionUS.apiURL+"/v1/customers?email=[REDACTED]&limit=[REDACTED]",
+			client.redactURL(RegionUS.apiURL+"/v1/customers?limit=10&email=a%40b.com"))
+	})
+
+	t.Run("redactBody", func(t *testing.T) {
+		assert.Equal(t, `{"data":[{"Email":"[REDACTED]"}],"phone":"[REDACTED]"}`,
+			client.redactBody([]byte(`{"phone":"555","data":[{"Email":"a@b.com"}]}`)))
+		assert.Equal(t, "not json [REDACTED]", client.redactBody([]byte("not json "+testAppAPIKey)))
+	})
+}
@@ -0,0 +1,131 @@
+package customerio
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// MetricsPeriod is the length of each step in a metrics time series
+type MetricsPeriod string
+
+// Allowed metrics periods
+const (
+	MetricsPeriodHours  MetricsPeriod = "hours"
+	MetricsPeriodDays   MetricsPeriod = "days"
+	MetricsPeriodWeeks  MetricsPeriod = "weeks"
+	MetricsPeriodMonths MetricsPeriod = "months"
+)
+
+// acceptedMetricsPeriods will return true if the period is accepted ("" uses the API default)
+func acceptedMetricsPeriods(period MetricsPeriod) bool {
+	switch period {
+	case "", MetricsPeriodHours, MetricsPeriodDays, MetricsPeriodWeeks, MetricsPeriodMonths:
+		return true
+	}
+	return false
+}
+
+// MetricsOptions are the options for the metrics endpoints
+type MetricsOptions struct {
+	Period MetricsPeriod // Period is the length of each step (defaults to days)
+	Steps  int           // Steps is the number of periods to return (the API max varies per period)
+	Type   string        // Type filters by the message type (IE: "email" or "push"), not used for link metrics
+	Unique bool          // Unique will only count unique clicks (only used for link metrics)
+}
+
+// values will return the query parameters for the options
+func (m *MetricsOptions) values(links bool) url.Values {
+	v := url.Values{}
+	if m == nil {
+		return v
+	}
+	if m.Period != "" {
+		v.Set("period", string(m.Period))
+	}
+	if m.Steps > 0 {
+		v.Set("steps", strconv.Itoa(m.Steps))
+	}
+	if links {
+		if m.Unique {
+			v.Set("unique", "true")
+		}
+	} else if m.Type != "" {
+		v.Set("type", m.Type)
+	}
+	return v
+}
+
+// MetricSeries is a time series of message metrics, one value per period (oldest first)
+type MetricSeries struct {
+	Attempted     []int64 `json:"attempted"`
+	Bounced       []int64 `json:"bounced"`
+	Clicked       []int64 `json:"clicked"`
+	Converted     []int64 `json:"converted"`
+	Created       []int64 `json:"created"`
+	Delivered     []int64 `json:"delivered"`
+	Drafted       []int64 `json:"drafted"`
+	Failed        []int64 `json:"failed"`
+	Opened        []int64 `json:"opened"`
+	Sent          []int64 `json:"sent"`
+	Spammed       []int64 `json:"spammed"`
+	Suppressed    []int64 `json:"suppressed"`
+	Undeliverable []int64 `json:"undeliverable"`
+	Unsubscribed  []int64 `json:"unsubscribed"`
+}
+
+// Link is a tracked link in a message
+type Link struct {
+	Href string `json:"href"`
+	ID   int64  `json:"id"`
+}
+
+// LinkMetrics is the time series of clicks (and conversions) for a link
+type LinkMetrics struct {
+	Link   Link         `json:"link"`
+	Series MetricSeries `json:"series"`
+}
+
+// getMetrics will return the metrics time series for the path (IE: "/v1/campaigns/1/metrics")
+func (c *Client) getMetrics(ctx context.Context, operation, path string,
+	opts *MetricsOptions) (*MetricSeries, error) {
+	if opts != nil && !acceptedMetricsPeriods(opts.Period) {
+		return nil, ParamError{Param: "period"}
+	}
+	var r struct {
+		Metric struct {
+			Series *MetricSeries `json:"series"`
+		} `json:"metric"`
+	}
+	if err := c.getAppResource(ctx, operation, path, opts.values(false), &r); err != nil {
+		return nil, err
+	}
+	if r.Metric.Series == nil {
+		return new(MetricSeries), nil
+	}
+	return r.Metric.Series, nil
+}
+
+// getLinkMetrics will return the link metrics for the path (IE: "/v1/campaigns/1/metrics/links")
+func (c *Client) getLinkMetrics(ctx context.Context, operation, path string,
+	opts *MetricsOptions) ([]*LinkMetrics, error) {
+	if opts != nil && !acceptedMetricsPeriods(opts.Period) {
+		return nil, ParamError{Param: "period"}
+	}
+	var r struct {
+		Links []struct {
+			Link   Link `json:"link"`
+			Metric struct {
+				Series MetricSeries `json:"series"`
+			} `json:"metric"`
+		} `json:"links"`
+	}
+	if err := c.getAppResource(ctx, operation, path, opts.values(true), &r); err != nil {
+		return nil, err
+	}
+	links := make([]*LinkMetrics, 0, len(r.Links))
+	for _, link := range r.Links {
+		links = append(links, &LinkMetrics{Link: link.Link, Series: link.Metric.Series})
+	}
+	return links, nil
+}
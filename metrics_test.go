@@ -0,0 +1,52 @@
+package customerio
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// testMetricsResponse is the response for a metrics time series (2 steps)
+const testMetricsResponse = `{"metric":{"series":{"sent":[10,20],"delivered":[9,19],"opened":[5,8],` +
+	`"clicked":[2,3],"converted":[1,0]}}}`
+
+// testLinkMetricsResponse is the response for the link metrics (2 steps)
+const testLinkMetricsResponse = `{"links":[{"link":{"id":1,"href":"https://example.com/pricing"},` +
+	`"metric":{"series":{"clicked":[4,2]}}}]}`
+
+// TestMetricsOptions_values will test the method values()
+func TestMetricsOptions_values(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil options", func(t *testing.T) {
+		var opts *MetricsOptions
+		assert.Empty(t, opts.values(false))
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		opts := &MetricsOptions{Period: MetricsPeriodWeeks, Steps: 4, Type: "email", Unique: true}
+		assert.Equal(t, "period=weeks&steps=4&type=email", opts.values(false).Encode())
+	})
+
+	t.Run("link metrics", func(t *testing.T) {
+		opts := &MetricsOptions{Period: MetricsPeriodDays, Steps: 7, Type: "email", Unique: true}
+		assert.Equal(t, "period=days&steps=7&unique=true", opts.values(true).Encode())
+	})
+}
+
+// mockAppResource is used for mocking the response of an App API GET request
+func mockAppResource(statusCode int, path, query, body string) {
+	httpmock.Reset()
+	requestURL := fmt.Sprintf("%s%s", testAppAPIURL, path)
+	if query != "" {
+		requestURL += "?" + query
+	}
+	httpmock.RegisterResponder(http.MethodGet, requestURL,
+		httpmock.NewStringResponder(
+			statusCode, body,
+		),
+	)
+}
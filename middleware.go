@@ -0,0 +1,26 @@
+package customerio
+
+import (
+	"context"
+	"net/http"
+)
+
+// Request is an outgoing API request (as seen by the middleware)
+//
+// The middleware can change any of the fields before calling the next RoundTrip
+type Request struct {
+	Body      []byte      // Body is the marshalled JSON body (empty for GET and DELETE)
+	Family    APIFamily   // Family is the API family (decides the authorization, rate limit and error type)
+	Header    http.Header // Header is added to the outgoing request (IE: correlation ids)
+	Method    string      // Method is the http method (GET, POST, PUT or DELETE)
+	Operation string      // Operation is the method that made the request (IE: customerio.UpdateCustomer)
+	URL       string      // URL is the full request URL
+}
+
+// RoundTrip will send the request and return the response
+type RoundTrip func(ctx context.Context, req *Request) (StandardResponse, error)
+
+// Middleware wraps a RoundTrip (IE: to add headers, log or capture metrics)
+//
+// A middleware can short-circuit by returning without calling next
+type Middleware func(next RoundTrip) RoundTrip
@@ -0,0 +1,234 @@
+package customerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestMiddlewareClient will return a test client using the middleware
+func newTestMiddlewareClient(middleware ...Middleware) (*Client, error) {
+	client, err := newTestClient()
+	if err != nil {
+		return nil, err
+	}
+	WithMiddleware(middleware...)(client.options)
+	return client, nil
+}
+
+// TestWithMiddleware will test the method WithMiddleware()
+func TestWithMiddleware(t *testing.T) {
+	t.Parallel()
+
+	noop := func(next RoundTrip) RoundTrip { return next }
+
+	client, err := NewClient(
+		WithTrackingKey(testSiteID, testTrackingAPIKey),
+		WithMiddleware(noop),
+		WithMiddleware(noop, noop),
+	)
+	assert.NoError(t, err)
+	assert.Len(t, client.options.middleware, 3)
+}
+
+// TestClient_requestMiddleware will test the method request() using middleware
+func TestClient_requestMiddleware(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("sees the request and response", func(t *testing.T) {
+		var seen *Request
+		var seenResp StandardResponse
+		client, err := newTestMiddlewareClient(func(next RoundTrip) RoundTrip {
+			return func(ctx context.Context, req *Request) (StandardResponse, error) {
+				seen = req
+				resp, err := next(ctx, req)
+				seenResp = resp
+				return resp, err
+			}
+		})
+		assert.NoError(t, err)
+
+		httpmock.Reset()
+		httpmock.RegisterResponder(http.MethodPost, testTrackingAPIURL+"middleware",
+			httpmock.NewStringResponder(http.StatusOK, `{"ok":true}`),
+		)
+
+		_, err = client.request(context.Background(), "Test", http.MethodPost, testTrackingAPIURL+"middleware",
+			map[string]interface{}{"name": "test"},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, http.MethodPost, seen.Method)
+		assert.Equal(t, testTrackingAPIURL+"middleware", seen.URL)
+		assert.Equal(t, APIFamilyTrack, seen.Family)
+		assert.Equal(t, `{"name":"test"}`, string(seen.Body))
+		assert.Equal(t, http.StatusOK, seenResp.StatusCode)
+		assert.Equal(t, `{"ok":true}`, string(seenResp.Body))
+	})
+
+	t.Run("sees the error", func(t *testing.T) {
+		var seenErr error
+		client, err := newTestMiddlewareClient(func(next RoundTrip) RoundTrip {
+			return func(ctx context.Context, req *Request) (StandardResponse, error) {
+				resp, err := next(ctx, req)
+				seenErr = err
+				return resp, err
+			}
+		})
+		assert.NoError(t, err)
+
+		mockTestAuth(http.StatusUnauthorized)
+
+		_, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"auth", nil)
+		assert.Error(t, err)
+		assert.True(t, errors.Is(seenErr, ErrUnauthorized))
+	})
+
+	t.Run("adds a header", func(t *testing.T) {
+		client, err := newTestMiddlewareClient(func(next RoundTrip) RoundTrip {
+			return func(ctx context.Context, req *Request) (StandardResponse, error) {
+				req.Header.Set("X-Correlation-Id", "abc123")
+				return next(ctx, req)
+			}
+		})
+		assert.NoError(t, err)
+
+		httpmock.Reset()
+		httpmock.RegisterResponder(http.MethodGet, testTrackingAPIURL+"middleware",
+			func(req *http.Request) (*http.Response, error) {
+				return httpmock.NewStringResponse(http.StatusOK, req.Header.Get("X-Correlation-Id")), nil
+			},
+		)
+
+		var resp StandardResponse
+		resp, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"middleware", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "abc123", string(resp.Body))
+	})
+
+	t.Run("short-circuit", func(t *testing.T) {
+		client, err := newTestMiddlewareClient(func(_ RoundTrip) RoundTrip {
+			return func(_ context.Context, _ *Request) (StandardResponse, error) {
+				return StandardResponse{StatusCode: http.StatusOK, Body: []byte(`cached`)}, nil
+			}
+		})
+		assert.NoError(t, err)
+
+		httpmock.Reset()
+
+		var resp StandardResponse
+		resp, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"middleware", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "cached", string(resp.Body))
+		assert.Equal(t, 0, httpmock.GetTotalCallCount())
+	})
+
+	t.Run("order (first is outermost)", func(t *testing.T) {
+		var order []string
+		named := func(name string) Middleware {
+			return func(next RoundTrip) RoundTrip {
+				return func(ctx context.Context, req *Request) (StandardResponse, error) {
+					order = append(order, name+" before")
+					resp, err := next(ctx, req)
+					order = append(order, name+" after")
+					return resp, err
+				}
+			}
+		}
+		client, err := newTestMiddlewareClient(named("first"), named("second"))
+		assert.NoError(t, err)
+
+		mockTestAuth(http.StatusOK)
+
+		_, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"auth", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"first before", "second before", "second after", "first after"}, order)
+	})
+}
+
+// ExampleWithMiddleware example using WithMiddleware()
+func ExampleWithMiddleware() {
+	client, err := NewClient(
+		WithTrackingKey(testSiteID, testTrackingAPIKey),
+		WithMiddleware(func(next RoundTrip) RoundTrip {
+			return func(ctx context.Context, req *Request) (StandardResponse, error) {
+				req.Header.Set("X-Correlation-Id", "abc123")
+				return next(ctx, req)
+			}
+		}),
+	)
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+	fmt.Printf("loaded client with %d middleware", len(client.options.middleware))
+	// Output:loaded client with 1 middleware
+}
+
+// TestRequest_Operation will test the operation name of the request
+func TestRequest_Operation(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	var operation string
+	client, err := newTestMiddlewareClient(func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, req *Request) (StandardResponse, error) {
+			operation = req.Operation
+			return next(ctx, req)
+		}
+	})
+	assert.NoError(t, err)
+
+	t.Run("client method", func(t *testing.T) {
+		mockUpdateCustomer(http.StatusOK, testCustomerID)
+		assert.NoError(t, client.UpdateCustomer(testCustomerID, map[string]interface{}{"plan": "basic"}))
+		assert.Equal(t, "customerio.UpdateCustomer", operation)
+	})
+
+	t.Run("client method (with context)", func(t *testing.T) {
+		mockTestAuth(http.StatusOK)
+		assert.NoError(t, client.TestAuthWithContext(context.Background()))
+		assert.Equal(t, "customerio.TestAuth", operation)
+	})
+
+	t.Run("batch method", func(t *testing.T) {
+		mockBatch(http.StatusOK, `{}`)
+		batch := client.NewBatch()
+		assert.NoError(t, batch.Identify(map[string]string{"id": testCustomerID}, nil))
+		_, err = batch.Send()
+		assert.NoError(t, err)
+		assert.Equal(t, "customerio.Batch.Send", operation)
+	})
+
+	t.Run("calls another method", func(t *testing.T) {
+		mockIdentifyAnonymous(http.StatusOK, testCustomerID, testAnonymousID)
+		assert.NoError(t, client.IdentifyAnonymous(testCustomerID, testAnonymousID, nil))
+		assert.Equal(t, "customerio.IdentifyAnonymous", operation)
+
+		mockGetCustomerResource(http.StatusOK, testCustomerID, "attributes", "", testCustomerAttributesResponse)
+		_, err = client.GetCustomerDevices(testCustomerID, IDTypeID)
+		assert.NoError(t, err)
+		assert.Equal(t, "customerio.GetCustomerDevices", operation)
+
+		mockNewEvent(http.StatusOK, testCustomerID)
+		assert.NoError(t, client.NewEventUsingInterface(testCustomerID, testEventName, time.Now(), nil))
+		assert.Equal(t, "customerio.NewEventUsingInterface", operation)
+	})
+
+	t.Run("generic function", func(t *testing.T) {
+		mockUpdateCollection(http.StatusOK, testCollectionID)
+		assert.NoError(t, UpdateCollectionOf(client, testCollectionID, testCollectionName,
+			[]testCollectionItem{{ID: 1, Name: "test_item_1"}},
+		))
+		assert.Equal(t, "customerio.UpdateCollectionOf", operation)
+
+		mockCollection(http.StatusOK, http.MethodGet, "/"+testCollectionID+"/content", `[]`)
+		_, err = GetCollectionContentsAs[testCollectionItem](client, testCollectionID)
+		assert.NoError(t, err)
+		assert.Equal(t, "customerio.GetCollectionContentsAs", operation)
+	})
+}
@@ -0,0 +1,150 @@
+package customerio
+
+import (
+	"context"
+	"fmt"
+)
+
+// Newsletter is a newsletter (one-off message) from the App API
+// See: https://customer.io/docs/api/app/#tag/Newsletters
+type Newsletter struct {
+	ContentIDs          []int64  `json:"content_ids"` // ContentIDs are the ids of the variants (A/B tests or languages)
+	Created             int64    `json:"created"`
+	DeduplicateID       string   `json:"deduplicate_id"`
+	ID                  int64    `json:"id"`
+	Name                string   `json:"name"`
+	RecipientSegmentIDs []int64  `json:"recipient_segment_ids"`
+	SentAt              int64    `json:"sent_at"`
+	Tags                []string `json:"tags"`
+	Type                string   `json:"type"`
+	Updated             int64    `json:"updated"`
+}
+
+// ListNewsletters will return a page of the newsletters in the workspace and the cursor for the next page
+// See: https://customer.io/docs/api/app/#operation/listNewsletters
+func (c *Client) ListNewsletters(opts *ListOptions) ([]*Newsletter, string, error) {
+	return c.ListNewslettersWithContext(context.Background(), opts)
+}
+
+// ListNewslettersWithContext is the same as ListNewsletters() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/listNewsletters
+func (c *Client) ListNewslettersWithContext(ctx context.Context, opts *ListOptions) ([]*Newsletter, string, error) {
+	var r struct {
+		Newsletters []*Newsletter `json:"newsletters"`
+		Next        string        `json:"next"`
+	}
+	if err := c.getAppResource(ctx, "ListNewsletters", "/v1/newsletters", opts.values(), &r); err != nil {
+		return nil, "", err
+	}
+	return r.Newsletters, r.Next, nil
+}
+
+// NewslettersPaginator will return a paginator over all the newsletters in the workspace
+// See: https://customer.io/docs/api/app/#operation/listNewsletters
+func (c *Client) NewslettersPaginator(opts ...PaginatorOps) *Paginator[*Newsletter] {
+	return NewPaginator(c.ListNewslettersWithContext, opts...)
+}
+
+// GetNewsletter will return a newsletter
+// See: https://customer.io/docs/api/app/#operation/getNewsletters
+func (c *Client) GetNewsletter(newsletterID int64) (*Newsletter, error) {
+	return c.GetNewsletterWithContext(context.Background(), newsletterID)
+}
+
+// GetNewsletterWithContext is the same as GetNewsletter() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getNewsletters
+func (c *Client) GetNewsletterWithContext(ctx context.Context, newsletterID int64) (*Newsletter, error) {
+	if newsletterID <= 0 {
+		return nil, ParamError{Param: "newsletterID"}
+	}
+	var r struct {
+		Newsletter *Newsletter `json:"newsletter"`
+	}
+	if err := c.getAppResource(
+		ctx, "GetNewsletter", fmt.Sprintf("/v1/newsletters/%d", newsletterID), nil, &r,
+	); err != nil {
+		return nil, err
+	}
+	return r.Newsletter, nil
+}
+
+// GetNewsletterMetrics will return the metrics time series for a newsletter
+// See: https://customer.io/docs/api/app/#operation/newsletterMetrics
+func (c *Client) GetNewsletterMetrics(newsletterID int64, opts *MetricsOptions) (*MetricSeries, error) {
+	return c.GetNewsletterMetricsWithContext(context.Background(), newsletterID, opts)
+}
+
+// GetNewsletterMetricsWithContext is the same as GetNewsletterMetrics() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/newsletterMetrics
+func (c *Client) GetNewsletterMetricsWithContext(ctx context.Context, newsletterID int64,
+	opts *MetricsOptions) (*MetricSeries, error) {
+	if newsletterID <= 0 {
+		return nil, ParamError{Param: "newsletterID"}
+	}
+	return c.getMetrics(ctx, "GetNewsletterMetrics", fmt.Sprintf("/v1/newsletters/%d/metrics", newsletterID), opts)
+}
+
+// GetNewsletterLinkMetrics will return the metrics time series for each link in a newsletter
+// See: https://customer.io/docs/api/app/#operation/newsletterLinkMetrics
+func (c *Client) GetNewsletterLinkMetrics(newsletterID int64, opts *MetricsOptions) ([]*LinkMetrics, error) {
+	return c.GetNewsletterLinkMetricsWithContext(context.Background(), newsletterID, opts)
+}
+
+// GetNewsletterLinkMetricsWithContext is the same as GetNewsletterLinkMetrics() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/newsletterLinkMetrics
+func (c *Client) GetNewsletterLinkMetricsWithContext(ctx context.Context, newsletterID int64,
+	opts *MetricsOptions) ([]*LinkMetrics, error) {
+	if newsletterID <= 0 {
+		return nil, ParamError{Param: "newsletterID"}
+	}
+	return c.getLinkMetrics(
+		ctx, "GetNewsletterLinkMetrics", fmt.Sprintf("/v1/newsletters/%d/metrics/links", newsletterID), opts,
+	)
+}
+
+// ListNewsletterVariants will return the variants (A/B tests or languages) of a newsletter
+// See: https://customer.io/docs/api/app/#operation/getNewsletterVariants
+func (c *Client) ListNewsletterVariants(newsletterID int64) ([]*Action, error) {
+	return c.ListNewsletterVariantsWithContext(context.Background(), newsletterID)
+}
+
+// ListNewsletterVariantsWithContext is the same as ListNewsletterVariants() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getNewsletterVariants
+func (c *Client) ListNewsletterVariantsWithContext(ctx context.Context, newsletterID int64) ([]*Action, error) {
+	if newsletterID <= 0 {
+		return nil, ParamError{Param: "newsletterID"}
+	}
+	var r struct {
+		Contents []*Action `json:"contents"`
+	}
+	if err := c.getAppResource(
+		ctx, "ListNewsletterVariants", fmt.Sprintf("/v1/newsletters/%d/contents", newsletterID), nil, &r,
+	); err != nil {
+		return nil, err
+	}
+	return r.Contents, nil
+}
+
+// GetNewsletterVariantMetrics will return the metrics time series for a variant of a newsletter
+// See: https://customer.io/docs/api/app/#operation/newsletterVariantMetrics
+func (c *Client) GetNewsletterVariantMetrics(newsletterID, contentID int64,
+	opts *MetricsOptions) (*MetricSeries, error) {
+	return c.GetNewsletterVariantMetricsWithContext(context.Background(), newsletterID, contentID, opts)
+}
+
+// GetNewsletterVariantMetricsWithContext is the same as GetNewsletterVariantMetrics() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/newsletterVariantMetrics
+func (c *Client) GetNewsletterVariantMetricsWithContext(ctx context.Context, newsletterID, contentID int64,
+	opts *MetricsOptions) (*MetricSeries, error) {
+	if newsletterID <= 0 {
+		return nil, ParamError{Param: "newsletterID"}
+	} else if contentID <= 0 {
+		return nil, ParamError{Param: "contentID"}
+	}
+	return c.getMetrics(
+		ctx,
+		"GetNewsletterVariantMetrics",
+		fmt.Sprintf("/v1/newsletters/%d/contents/%d/metrics", newsletterID, contentID),
+		opts,
+	)
+}
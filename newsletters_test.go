@@ -0,0 +1,235 @@
+package customerio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testContentID    int64 = 21
+	testNewsletterID int64 = 14
+)
+
+// TestClient_ListNewsletters will test the method ListNewsletters()
+func TestClient_ListNewsletters(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK, "v1/newsletters", "limit=1",
+			`{"newsletters":[{"id":14,"name":"October update","content_ids":[21,22],"sent_at":1600000000}],"next":"abc"}`,
+		)
+
+		var newsletters []*Newsletter
+		var next string
+		newsletters, next, err = client.ListNewsletters(&ListOptions{Limit: 1})
+		assert.NoError(t, err)
+		assert.Len(t, newsletters, 1)
+		assert.Equal(t, testNewsletterID, newsletters[0].ID)
+		assert.Equal(t, []int64{testContentID, 22}, newsletters[0].ContentIDs)
+		assert.Equal(t, "abc", next)
+	})
+
+	t.Run("paginator", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK, "v1/newsletters", "", `{"newsletters":[{"id":14}],"next":"abc"}`)
+		httpmock.RegisterResponder(http.MethodGet, testAppAPIURL+"v1/newsletters?start=abc",
+			httpmock.NewStringResponder(http.StatusOK, `{"newsletters":[{"id":15}],"next":""}`),
+		)
+
+		var newsletters []*Newsletter
+		newsletters, err = client.NewslettersPaginator().All(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, newsletters, 2)
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusUnauthorized, "v1/newsletters", "", "")
+
+		_, _, err = client.ListNewsletters(nil)
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_GetNewsletter will test the method GetNewsletter()
+func TestClient_GetNewsletter(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK, fmt.Sprintf("v1/newsletters/%d", testNewsletterID), "",
+			`{"newsletter":{"id":14,"name":"October update","recipient_segment_ids":[7]}}`,
+		)
+
+		var newsletter *Newsletter
+		newsletter, err = client.GetNewsletter(testNewsletterID)
+		assert.NoError(t, err)
+		assert.NotNil(t, newsletter)
+		assert.Equal(t, "October update", newsletter.Name)
+		assert.Equal(t, []int64{testSegmentID}, newsletter.RecipientSegmentIDs)
+	})
+
+	t.Run("missing newsletter id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetNewsletter(0)
+		checkParamError(t, err, "newsletterID")
+	})
+}
+
+// TestClient_GetNewsletterMetrics will test the method GetNewsletterMetrics()
+func TestClient_GetNewsletterMetrics(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK, fmt.Sprintf("v1/newsletters/%d/metrics", testNewsletterID),
+			"period=hours&steps=2", testMetricsResponse,
+		)
+
+		var series *MetricSeries
+		series, err = client.GetNewsletterMetrics(testNewsletterID, &MetricsOptions{
+			Period: MetricsPeriodHours, Steps: 2,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{9, 19}, series.Delivered)
+	})
+
+	t.Run("link metrics", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK, fmt.Sprintf("v1/newsletters/%d/metrics/links", testNewsletterID), "",
+			testLinkMetricsResponse,
+		)
+
+		var links []*LinkMetrics
+		links, err = client.GetNewsletterLinkMetrics(testNewsletterID, nil)
+		assert.NoError(t, err)
+		assert.Len(t, links, 1)
+		assert.Equal(t, int64(1), links[0].Link.ID)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetNewsletterMetrics(0, nil)
+		checkParamError(t, err, "newsletterID")
+
+		_, err = client.GetNewsletterLinkMetrics(0, nil)
+		checkParamError(t, err, "newsletterID")
+	})
+}
+
+// TestClient_ListNewsletterVariants will test the method ListNewsletterVariants()
+func TestClient_ListNewsletterVariants(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK, fmt.Sprintf("v1/newsletters/%d/contents", testNewsletterID), "",
+			`{"contents":[{"id":21,"newsletter_id":14,"language":"en"},{"id":22,"newsletter_id":14,"language":"fr"}]}`,
+		)
+
+		var variants []*Action
+		variants, err = client.ListNewsletterVariants(testNewsletterID)
+		assert.NoError(t, err)
+		assert.Len(t, variants, 2)
+		assert.Equal(t, testNewsletterID, variants[0].NewsletterID)
+		assert.Equal(t, "fr", variants[1].Language)
+	})
+
+	t.Run("missing newsletter id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.ListNewsletterVariants(0)
+		checkParamError(t, err, "newsletterID")
+	})
+}
+
+// TestClient_GetNewsletterVariantMetrics will test the method GetNewsletterVariantMetrics()
+func TestClient_GetNewsletterVariantMetrics(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockAppResource(http.StatusOK,
+			fmt.Sprintf("v1/newsletters/%d/contents/%d/metrics", testNewsletterID, testContentID), "",
+			testMetricsResponse,
+		)
+
+		var series *MetricSeries
+		series, err = client.GetNewsletterVariantMetrics(testNewsletterID, testContentID, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 3}, series.Clicked)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetNewsletterVariantMetrics(testNewsletterID, 0, nil)
+		checkParamError(t, err, "contentID")
+	})
+}
+
+// ExampleClient_GetNewsletterMetrics example using GetNewsletterMetrics()
+//
+// See more examples in /examples/
+func ExampleClient_GetNewsletterMetrics() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockAppResource(http.StatusOK, fmt.Sprintf("v1/newsletters/%d/metrics", testNewsletterID), "",
+		testMetricsResponse,
+	)
+
+	// Get the newsletter metrics
+	var series *MetricSeries
+	if series, err = client.GetNewsletterMetrics(testNewsletterID, nil); err != nil {
+		fmt.Printf("error getting metrics: %s", err.Error())
+		return
+	}
+	fmt.Printf("delivered: %v", series.Delivered)
+	// Output:delivered: [9 19]
+}
@@ -0,0 +1,178 @@
+package customerio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ObjectIdentifier identifies a single object (IE: a company or account)
+// See: https://customer.io/docs/objects/
+type ObjectIdentifier struct {
+	ObjectID     string // ObjectID is the unique id of the object (within the object type)
+	ObjectTypeID string // ObjectTypeID is the id of the object type (IE: "1" for companies)
+}
+
+// NewObjectIdentifier will return an identifier for the object
+func NewObjectIdentifier(objectTypeID, objectID string) ObjectIdentifier {
+	return ObjectIdentifier{ObjectID: objectID, ObjectTypeID: objectTypeID}
+}
+
+// validate will return a ParamError if the object type id or object id is missing
+func (o ObjectIdentifier) validate() error {
+	if o.ObjectTypeID == "" {
+		return ParamError{Param: "objectTypeID"}
+	} else if o.ObjectID == "" {
+		return ParamError{Param: "objectID"}
+	}
+	return nil
+}
+
+// Map will return the identifier as a map (IE: {"object_type_id": "1", "object_id": "acme"})
+func (o ObjectIdentifier) Map() map[string]string {
+	return map[string]string{
+		batchIdentifierObjectTypeID: o.ObjectTypeID,
+		batchIdentifierObjectID:     o.ObjectID,
+	}
+}
+
+// Relationship is a relationship between an object and a person
+// Attributes describe the relationship itself (IE: {"role": "admin"})
+type Relationship struct {
+	Attributes map[string]interface{} `json:"relationship_attributes,omitempty"`
+	Identifier Identifier             `json:"identifiers"`
+}
+
+// NewRelationship will return a relationship to the person (using their id or email)
+// Values containing an "@" are treated as an email, everything else as an id
+func NewRelationship(customerIDOrEmail string, attributes map[string]interface{}) Relationship {
+	return Relationship{Attributes: attributes, Identifier: customerIdentifier(customerIDOrEmail)}
+}
+
+// validateRelationships will check that there is at least one relationship and each has a valid identifier
+func validateRelationships(relationships []Relationship) error {
+	if len(relationships) == 0 {
+		return ParamError{Param: "relationships"}
+	}
+	for _, relationship := range relationships {
+		if !relationship.Identifier.valid() {
+			return ParamError{Param: "relationshipIdentifier"}
+		}
+	}
+	return nil
+}
+
+// UpdateObject will create/update an object and set its attributes
+// Optionally, people can be related to the object at the same time
+// See: https://customer.io/docs/api/track/#operation/entity
+func (c *Client) UpdateObject(object ObjectIdentifier, attributes map[string]interface{},
+	relationships ...Relationship) error {
+	return c.UpdateObjectWithContext(context.Background(), object, attributes, relationships...)
+}
+
+// UpdateObjectWithContext is the same as UpdateObject() but uses the given context
+// See: https://customer.io/docs/api/track/#operation/entity
+func (c *Client) UpdateObjectWithContext(ctx context.Context, object ObjectIdentifier,
+	attributes map[string]interface{}, relationships ...Relationship) error {
+	if err := object.validate(); err != nil {
+		return err
+	}
+	if len(relationships) > 0 {
+		if err := validateRelationships(relationships); err != nil {
+			return err
+		}
+	}
+	return c.sendEntity(ctx, "UpdateObject", &BatchOperation{
+		Action:        BatchActionIdentify,
+		Attributes:    attributes,
+		Identifiers:   object.Map(),
+		Relationships: relationships,
+		Type:          batchEntityTypeObject,
+	})
+}
+
+// DeleteObject will remove an object
+// See: https://customer.io/docs/api/track/#operation/entity
+func (c *Client) DeleteObject(object ObjectIdentifier) error {
+	return c.DeleteObjectWithContext(context.Background(), object)
+}
+
+// DeleteObjectWithContext is the same as DeleteObject() but uses the given context
+// See: https://customer.io/docs/api/track/#operation/entity
+func (c *Client) DeleteObjectWithContext(ctx context.Context, object ObjectIdentifier) error {
+	if err := object.validate(); err != nil {
+		return err
+	}
+	return c.sendEntity(ctx, "DeleteObject", &BatchOperation{
+		Action:      BatchActionDelete,
+		Identifiers: object.Map(),
+		Type:        batchEntityTypeObject,
+	})
+}
+
+// AddObjectRelationships will relate people to an object (with optional relationship attributes)
+// See: https://customer.io/docs/api/track/#operation/entity
+func (c *Client) AddObjectRelationships(object ObjectIdentifier, relationships ...Relationship) error {
+	return c.AddObjectRelationshipsWithContext(context.Background(), object, relationships...)
+}
+
+// AddObjectRelationshipsWithContext is the same as AddObjectRelationships() but uses the given context
+// See: https://customer.io/docs/api/track/#operation/entity
+func (c *Client) AddObjectRelationshipsWithContext(ctx context.Context, object ObjectIdentifier,
+	relationships ...Relationship) error {
+	op, err := objectRelationshipsOperation(BatchActionAddRelationships, object, relationships)
+	if err != nil {
+		return err
+	}
+	return c.sendEntity(ctx, "AddObjectRelationships", op)
+}
+
+// RemoveObjectRelationships will remove the relationships between people and an object
+// See: https://customer.io/docs/api/track/#operation/entity
+func (c *Client) RemoveObjectRelationships(object ObjectIdentifier, relationships ...Relationship) error {
+	return c.RemoveObjectRelationshipsWithContext(context.Background(), object, relationships...)
+}
+
+// RemoveObjectRelationshipsWithContext is the same as RemoveObjectRelationships() but uses the given context
+// See: https://customer.io/docs/api/track/#operation/entity
+func (c *Client) RemoveObjectRelationshipsWithContext(ctx context.Context, object ObjectIdentifier,
+	relationships ...Relationship) error {
+	op, err := objectRelationshipsOperation(BatchActionDeleteRelationships, object, relationships)
+	if err != nil {
+		return err
+	}
+	return c.sendEntity(ctx, "RemoveObjectRelationships", op)
+}
+
+// objectRelationshipsOperation will validate and return the operation to add/remove relationships
+func objectRelationshipsOperation(action BatchAction, object ObjectIdentifier,
+	relationships []Relationship) (*BatchOperation, error) {
+	if err := object.validate(); err != nil {
+		return nil, err
+	}
+	if err := validateRelationships(relationships); err != nil {
+		return nil, err
+	}
+	return &BatchOperation{
+		Action:        action,
+		Identifiers:   object.Map(),
+		Relationships: relationships,
+		Type:          batchEntityTypeObject,
+	}, nil
+}
+
+// sendEntity will send a single operation using the Track API v2 entity endpoint
+// See: https://customer.io/docs/api/track/#operation/entity
+func (c *Client) sendEntity(ctx context.Context, operation string, entity *BatchOperation) error {
+	if err := validateBatchOperation(entity); err != nil {
+		return err
+	}
+	_, err := c.request(
+		ctx,
+		operation,
+		http.MethodPost,
+		fmt.Sprintf("%s/api/v2/entity", c.options.trackURL),
+		entity,
+	)
+	return err
+}
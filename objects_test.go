@@ -0,0 +1,250 @@
+package customerio
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testObjectID     = "acme"
+	testObjectTypeID = "1"
+)
+
+// TestClient_UpdateObject will test the method UpdateObject()
+func TestClient_UpdateObject(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockEntity(http.StatusOK, BatchActionIdentify, 0)
+
+		err = client.UpdateObject(
+			NewObjectIdentifier(testObjectTypeID, testObjectID),
+			map[string]interface{}{"name": "Acme"},
+		)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with relationships", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockEntity(http.StatusOK, BatchActionIdentify, 2)
+
+		err = client.UpdateObject(
+			NewObjectIdentifier(testObjectTypeID, testObjectID),
+			map[string]interface{}{"name": "Acme"},
+			NewRelationship(testCustomerID, map[string]interface{}{"role": "admin"}),
+			NewRelationship(testCustomerEmail, nil),
+		)
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing object type id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.UpdateObject(NewObjectIdentifier("", testObjectID), nil)
+		checkParamError(t, err, "objectTypeID")
+	})
+
+	t.Run("missing object id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.UpdateObject(NewObjectIdentifier(testObjectTypeID, ""), nil)
+		checkParamError(t, err, "objectID")
+	})
+
+	t.Run("invalid relationship", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.UpdateObject(NewObjectIdentifier(testObjectTypeID, testObjectID), nil, Relationship{})
+		checkParamError(t, err, "relationshipIdentifier")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockEntity(http.StatusUnauthorized, BatchActionIdentify, 0)
+
+		err = client.UpdateObject(NewObjectIdentifier(testObjectTypeID, testObjectID), nil)
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_DeleteObject will test the method DeleteObject()
+func TestClient_DeleteObject(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockEntity(http.StatusOK, BatchActionDelete, 0)
+
+		err = client.DeleteObject(NewObjectIdentifier(testObjectTypeID, testObjectID))
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing object id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.DeleteObject(NewObjectIdentifier(testObjectTypeID, ""))
+		checkParamError(t, err, "objectID")
+	})
+}
+
+// TestClient_AddObjectRelationships will test the method AddObjectRelationships()
+func TestClient_AddObjectRelationships(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockEntity(http.StatusOK, BatchActionAddRelationships, 1)
+
+		err = client.AddObjectRelationships(
+			NewObjectIdentifier(testObjectTypeID, testObjectID),
+			NewRelationship(testCustomerID, map[string]interface{}{"role": "admin"}),
+		)
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing relationships", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.AddObjectRelationships(NewObjectIdentifier(testObjectTypeID, testObjectID))
+		checkParamError(t, err, "relationships")
+	})
+
+	t.Run("missing object type id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.AddObjectRelationships(NewObjectIdentifier("", testObjectID), NewRelationship(testCustomerID, nil))
+		checkParamError(t, err, "objectTypeID")
+	})
+}
+
+// TestClient_RemoveObjectRelationships will test the method RemoveObjectRelationships()
+func TestClient_RemoveObjectRelationships(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockEntity(http.StatusOK, BatchActionDeleteRelationships, 1)
+
+		err = client.RemoveObjectRelationships(
+			NewObjectIdentifier(testObjectTypeID, testObjectID),
+			NewRelationship(testCustomerEmail, nil),
+		)
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid relationship", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		err = client.RemoveObjectRelationships(
+			NewObjectIdentifier(testObjectTypeID, testObjectID),
+			Relationship{Identifier: Identifier{Type: "phone", Value: "555"}},
+		)
+		checkParamError(t, err, "relationshipIdentifier")
+	})
+}
+
+// TestNewRelationship will test the method NewRelationship()
+func TestNewRelationship(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, IdentifierID(testCustomerID), NewRelationship(testCustomerID, nil).Identifier)
+	assert.Equal(t, IdentifierEmail(testCustomerEmail), NewRelationship(testCustomerEmail, nil).Identifier)
+
+	j, err := json.Marshal(NewRelationship(testCustomerID, map[string]interface{}{"role": "admin"}))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"identifiers":{"id":"123"},"relationship_attributes":{"role":"admin"}}`, string(j))
+}
+
+// ExampleClient_UpdateObject example using UpdateObject()
+//
+// See more examples in /examples/
+func ExampleClient_UpdateObject() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockEntity(http.StatusOK, BatchActionIdentify, 1)
+
+	// Create the object and relate the customer
+	if err = client.UpdateObject(
+		NewObjectIdentifier(testObjectTypeID, testObjectID),
+		map[string]interface{}{"name": "Acme"},
+		NewRelationship(testCustomerID, map[string]interface{}{"role": "admin"}),
+	); err != nil {
+		fmt.Printf("error updating object: %s", err.Error())
+		return
+	}
+	fmt.Printf("object updated: %s", testObjectID)
+	// Output:object updated: acme
+}
+
+// BenchmarkClient_UpdateObject benchmarks the method UpdateObject()
+func BenchmarkClient_UpdateObject(b *testing.B) {
+	client, _ := newTestClient()
+	mockEntity(http.StatusOK, BatchActionIdentify, 0)
+	object := NewObjectIdentifier(testObjectTypeID, testObjectID)
+	for i := 0; i < b.N; i++ {
+		_ = client.UpdateObject(object, map[string]interface{}{"name": "Acme"})
+	}
+}
+
+// mockEntity is used for mocking the response
+// Responds with a 400 if the request body does not have the expected action, object or number of relationships
+func mockEntity(statusCode int, action BatchAction, relationships int) {
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%sapi/v2/entity", testTrackingAPIURL),
+		func(req *http.Request) (*http.Response, error) {
+			var op BatchOperation
+			if err := json.NewDecoder(req.Body).Decode(&op); err != nil ||
+				op.Action != action || op.Type != batchEntityTypeObject ||
+				op.Identifiers[batchIdentifierObjectTypeID] != testObjectTypeID ||
+				op.Identifiers[batchIdentifierObjectID] != testObjectID ||
+				len(op.Relationships) != relationships {
+				return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+			}
+			return httpmock.NewStringResponse(statusCode, ""), nil
+		},
+	)
+}
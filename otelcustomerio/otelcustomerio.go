@@ -0,0 +1,206 @@
+// Package otelcustomerio provides OpenTelemetry tracing and metrics for the go-customerio client
+//
+// A span is created for every Client method (IE: customerio.UpdateCustomer or customerio.SendEmail)
+// and the request count, latency and errors are recorded using the meter provider.
+//
+// Usage:
+//
+//	client, err := customerio.NewClient(
+//		customerio.WithTrackingKey(siteID, trackingAPIKey),
+//		otelcustomerio.WithTracing(),
+//	)
+package otelcustomerio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/mrz1836/go-customerio"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the tracer and meter
+const instrumentationName = "github.com/mrz1836/go-customerio/otelcustomerio"
+
+// Attribute keys set on the spans and metrics
+const (
+	APIFamilyKey      = attribute.Key("customerio.api_family")     // APIFamilyKey is the API family (track, app...)
+	DeliveryIDKey     = attribute.Key("customerio.delivery_id")    // DeliveryIDKey is the transactional delivery id
+	ErrorTypeKey      = attribute.Key("error.type")                // ErrorTypeKey is the class of the error
+	HTTPMethodKey     = attribute.Key("http.request.method")       // HTTPMethodKey is the http method
+	HTTPStatusCodeKey = attribute.Key("http.response.status_code") // HTTPStatusCodeKey is the http status
+	OperationKey      = attribute.Key("customerio.operation")      // OperationKey is the Client method
+	RetryCountKey     = attribute.Key("customerio.retry_count")    // RetryCountKey is the number of retries
+	ServerAddressKey  = attribute.Key("server.address")            // ServerAddressKey is the API host
+)
+
+// config holds all the configuration for the instrumentation
+type config struct {
+	meterProvider  metric.MeterProvider
+	propagator     propagation.TextMapPropagator
+	tracerProvider trace.TracerProvider
+}
+
+// Option allows functional options to be supplied
+// that overwrite the default configuration.
+type Option func(c *config)
+
+// WithMeterProvider will overwrite the meter provider.
+// Default is the global meter provider.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// WithPropagator will overwrite the propagator used to inject the trace context into the request headers.
+// Default is the global propagator.
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagator = propagator
+	}
+}
+
+// WithTracerProvider will overwrite the tracer provider.
+// Default is the global tracer provider.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithTracing will return a client option adding the OpenTelemetry middleware
+func WithTracing(opts ...Option) customerio.ClientOps {
+	return customerio.WithMiddleware(Middleware(opts...))
+}
+
+// Middleware will return a middleware that creates a span and records the metrics for every request
+func Middleware(opts ...Option) customerio.Middleware {
+	cfg := &config{
+		meterProvider:  otel.GetMeterProvider(),
+		propagator:     otel.GetTextMapPropagator(),
+		tracerProvider: otel.GetTracerProvider(),
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	tracer := cfg.tracerProvider.Tracer(instrumentationName)
+	meter := cfg.meterProvider.Meter(instrumentationName)
+
+	// Errors creating the instruments are handled by OTel (no-op instruments are returned)
+	requests, _ := meter.Int64Counter("customerio.client.requests",
+		metric.WithDescription("Number of requests sent to the Customer.io API"),
+		metric.WithUnit("{request}"),
+	)
+	failures, _ := meter.Int64Counter("customerio.client.errors",
+		metric.WithDescription("Number of failed requests to the Customer.io API"),
+		metric.WithUnit("{request}"),
+	)
+	duration, _ := meter.Float64Histogram("customerio.client.duration",
+		metric.WithDescription("Duration of requests to the Customer.io API (including retries)"),
+		metric.WithUnit("s"),
+	)
+
+	return func(next customerio.RoundTrip) customerio.RoundTrip {
+		return func(ctx context.Context, req *customerio.Request) (customerio.StandardResponse, error) {
+			attrs := []attribute.KeyValue{
+				OperationKey.String(req.Operation),
+				APIFamilyKey.String(string(req.Family)),
+				HTTPMethodKey.String(req.Method),
+			}
+			if u, err := url.Parse(req.URL); err == nil {
+				attrs = append(attrs, ServerAddressKey.String(u.Hostname()))
+			}
+
+			// Start the span and propagate the trace context
+			ctx, span := tracer.Start(ctx, req.Operation,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...),
+			)
+			defer span.End()
+			cfg.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+			start := time.Now()
+			response, err := next(ctx, req)
+			elapsed := time.Since(start)
+
+			// Set the response attributes
+			if response.StatusCode > 0 {
+				span.SetAttributes(HTTPStatusCodeKey.Int(response.StatusCode))
+			}
+			if response.Attempts > 1 {
+				span.SetAttributes(RetryCountKey.Int(response.Attempts - 1))
+			}
+			if deliveryID := deliveryID(req, response); deliveryID != "" {
+				span.SetAttributes(DeliveryIDKey.String(deliveryID))
+			}
+
+			// Record the metrics
+			metricAttrs := append(attrs[:0:0], attrs...)
+			if response.StatusCode > 0 {
+				metricAttrs = append(metricAttrs, HTTPStatusCodeKey.Int(response.StatusCode))
+			}
+			if err != nil {
+				// Only the class of the error is exported (the error text can have the customer email)
+				errType := errorType(err)
+				span.SetAttributes(ErrorTypeKey.String(errType))
+				span.SetStatus(codes.Error, errType)
+				metricAttrs = append(metricAttrs, ErrorTypeKey.String(errType))
+				failures.Add(ctx, 1, metric.WithAttributes(metricAttrs...))
+			}
+			requests.Add(ctx, 1, metric.WithAttributes(metricAttrs...))
+			duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(metricAttrs...))
+
+			return response, err
+		}
+	}
+}
+
+// deliveryID will return the delivery id of a successful transactional request (if any)
+func deliveryID(req *customerio.Request, response customerio.StandardResponse) string {
+	if req.Family != customerio.APIFamilyTransactional || response.StatusCode != http.StatusOK {
+		return ""
+	}
+	var body struct {
+		DeliveryID string `json:"delivery_id"`
+	}
+	if json.Unmarshal(response.Body, &body) != nil {
+		return ""
+	}
+	return body.DeliveryID
+}
+
+// errorType will return the class of the error (IE: rate_limited)
+func errorType(err error) string {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "deadline_exceeded"
+	case errors.Is(err, customerio.ErrUnauthorized):
+		return "unauthorized"
+	case errors.Is(err, customerio.ErrNotFound):
+		return "not_found"
+	case errors.Is(err, customerio.ErrPayloadTooLarge):
+		return "payload_too_large"
+	case errors.Is(err, customerio.ErrRateLimited):
+		return "rate_limited"
+	case errors.Is(err, customerio.ErrServer):
+		return "server_error"
+	}
+	var apiErr *customerio.APIError
+	if errors.As(err, &apiErr) {
+		return "client_error"
+	}
+	return "transport_error"
+}
@@ -0,0 +1,255 @@
+package otelcustomerio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/mrz1836/go-customerio"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const (
+	testAppAPIKey      = "TestAPIKey1234567"
+	testSiteID         = "TestSiteID1234567"
+	testTrackingAPIKey = "TestTrackingAPIKey1234567"
+)
+
+// testTelemetry holds the in-memory exporters
+type testTelemetry struct {
+	reader *sdkmetric.ManualReader
+	spans  *tracetest.InMemoryExporter
+}
+
+// newTestClient will return a mocked client using the in-memory exporters
+func newTestClient(t *testing.T, opts ...customerio.ClientOps) (*customerio.Client, *testTelemetry) {
+	telemetry := &testTelemetry{
+		reader: sdkmetric.NewManualReader(),
+		spans:  tracetest.NewInMemoryExporter(),
+	}
+
+	httpClient := resty.New()
+	httpmock.ActivateNonDefault(httpClient.GetClient())
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	client, err := customerio.NewClient(append([]customerio.ClientOps{
+		customerio.WithTrackingKey(testSiteID, testTrackingAPIKey),
+		customerio.WithAppKey(testAppAPIKey),
+		WithTracing(
+			WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(telemetry.spans))),
+			WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(telemetry.reader))),
+			WithPropagator(propagation.TraceContext{}),
+		),
+	}, opts...)...)
+	require.NoError(t, err)
+	client.WithCustomHTTPClient(httpClient)
+	return client, telemetry
+}
+
+// metrics will collect the metrics by name
+func (tt *testTelemetry) metrics(t *testing.T) map[string]metricdata.Metrics {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, tt.reader.Collect(context.Background(), &rm))
+	metrics := make(map[string]metricdata.Metrics)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m
+		}
+	}
+	return metrics
+}
+
+// spanAttributes will return the attributes of the span as a map
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value, len(span.Attributes))
+	for _, kv := range span.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+// TestMiddleware will test the method Middleware()
+func TestMiddleware(t *testing.T) {
+
+	t.Run("span per client method", func(t *testing.T) {
+		client, telemetry := newTestClient(t)
+
+		var traceparent string
+		httpmock.RegisterResponder(http.MethodPut, "https://track.customer.io/api/v1/customers/123",
+			func(req *http.Request) (*http.Response, error) {
+				traceparent = req.Header.Get("Traceparent")
+				return httpmock.NewStringResponse(http.StatusOK, ``), nil
+			},
+		)
+
+		require.NoError(t, client.UpdateCustomer("123", map[string]interface{}{"plan": "basic"}))
+
+		spans := telemetry.spans.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "customerio.UpdateCustomer", spans[0].Name)
+		assert.Equal(t, codes.Unset, spans[0].Status.Code)
+
+		attrs := spanAttributes(spans[0])
+		assert.Equal(t, "track", attrs[APIFamilyKey].AsString())
+		assert.Equal(t, http.MethodPut, attrs[HTTPMethodKey].AsString())
+		assert.Equal(t, int64(http.StatusOK), attrs[HTTPStatusCodeKey].AsInt64())
+		assert.Equal(t, "track.customer.io", attrs[ServerAddressKey].AsString())
+		assert.NotContains(t, attrs, RetryCountKey)
+
+		// The trace context is propagated to the request
+		assert.Contains(t, traceparent, spans[0].SpanContext.TraceID().String())
+	})
+
+	t.Run("delivery id (transactional)", func(t *testing.T) {
+		client, telemetry := newTestClient(t)
+
+		httpmock.RegisterResponder(http.MethodPost, "https://api.customer.io/v1/send/email",
+			httpmock.NewStringResponder(http.StatusOK,
+				`{"delivery_id":"ABCDEFG","queued_at":1500111111}`),
+		)
+
+		_, err := client.SendEmail(&customerio.EmailRequest{
+			Identifiers:            map[string]string{"id": "123"},
+			To:                     "test@example.com",
+			TransactionalMessageID: "1",
+		})
+		require.NoError(t, err)
+
+		spans := telemetry.spans.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "customerio.SendEmail", spans[0].Name)
+		attrs := spanAttributes(spans[0])
+		assert.Equal(t, "transactional", attrs[APIFamilyKey].AsString())
+		assert.Equal(t, "ABCDEFG", attrs[DeliveryIDKey].AsString())
+	})
+
+	t.Run("retry count", func(t *testing.T) {
+		client, telemetry := newTestClient(t, customerio.WithRetryPolicy(&customerio.RetryPolicy{MaxRetries: 2}))
+
+		httpmock.RegisterResponder(http.MethodGet, "https://track.customer.io/auth",
+			httpmock.ResponderFromMultipleResponses([]*http.Response{
+				httpmock.NewStringResponse(http.StatusServiceUnavailable, ``),
+				httpmock.NewStringResponse(http.StatusOK, `{}`),
+			}),
+		)
+
+		require.NoError(t, client.TestAuth())
+
+		spans := telemetry.spans.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, int64(1), spanAttributes(spans[0])[RetryCountKey].AsInt64())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		client, telemetry := newTestClient(t)
+
+		httpmock.RegisterResponder(http.MethodGet, "https://track.customer.io/auth",
+			httpmock.NewStringResponder(http.StatusTooManyRequests, `{"meta":{"error":"slow down"}}`),
+		)
+
+		err := client.TestAuth()
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, customerio.ErrRateLimited))
+
+		spans := telemetry.spans.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "customerio.TestAuth", spans[0].Name)
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+		assert.Equal(t, "rate_limited", spans[0].Status.Description)
+		assert.Equal(t, "rate_limited", spanAttributes(spans[0])[ErrorTypeKey].AsString())
+
+		metrics := telemetry.metrics(t)
+		failures, ok := metrics["customerio.client.errors"].Data.(metricdata.Sum[int64])
+		require.True(t, ok)
+		require.Len(t, failures.DataPoints, 1)
+		errorType, _ := failures.DataPoints[0].Attributes.Value(ErrorTypeKey)
+		assert.Equal(t, "rate_limited", errorType.AsString())
+	})
+
+	t.Run("error does not export the customer email", func(t *testing.T) {
+		client, telemetry := newTestClient(t)
+
+		httpmock.RegisterResponder(http.MethodPut, "https://track.customer.io/api/v1/customers/test@example.com",
+			httpmock.NewStringResponder(http.StatusBadRequest, `{"meta":{"error":"invalid test@example.com"}}`),
+		)
+
+		err := client.UpdateCustomer("test@example.com", map[string]interface{}{"plan": "basic"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "test@example.com")
+
+		spans := telemetry.spans.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+		assert.Equal(t, "client_error", spans[0].Status.Description)
+		assert.Empty(t, spans[0].Events)
+		for _, kv := range spans[0].Attributes {
+			assert.NotContains(t, kv.Value.Emit(), "example.com", string(kv.Key))
+		}
+	})
+
+	t.Run("parent span", func(t *testing.T) {
+		client, telemetry := newTestClient(t)
+		tracer := sdktrace.NewTracerProvider(sdktrace.WithSyncer(telemetry.spans)).Tracer("test")
+
+		httpmock.RegisterResponder(http.MethodGet, "https://track.customer.io/auth",
+			httpmock.NewStringResponder(http.StatusOK, `{}`),
+		)
+
+		ctx, parent := tracer.Start(context.Background(), "parent")
+		require.NoError(t, client.TestAuthWithContext(ctx))
+		parent.End()
+
+		spans := telemetry.spans.GetSpans()
+		require.Len(t, spans, 2)
+		assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+		assert.Equal(t, parent.SpanContext().TraceID(), spans[0].SpanContext.TraceID())
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		client, telemetry := newTestClient(t)
+
+		httpmock.RegisterResponder(http.MethodGet, "https://track.customer.io/auth",
+			httpmock.NewStringResponder(http.StatusOK, `{}`),
+		)
+
+		require.NoError(t, client.TestAuth())
+		require.NoError(t, client.TestAuth())
+
+		metrics := telemetry.metrics(t)
+		requests, ok := metrics["customerio.client.requests"].Data.(metricdata.Sum[int64])
+		require.True(t, ok)
+		require.Len(t, requests.DataPoints, 1)
+		assert.Equal(t, int64(2), requests.DataPoints[0].Value)
+		operation, _ := requests.DataPoints[0].Attributes.Value(OperationKey)
+		assert.Equal(t, "customerio.TestAuth", operation.AsString())
+
+		duration, ok := metrics["customerio.client.duration"].Data.(metricdata.Histogram[float64])
+		require.True(t, ok)
+		require.Len(t, duration.DataPoints, 1)
+		assert.Equal(t, uint64(2), duration.DataPoints[0].Count)
+		assert.Equal(t, "s", metrics["customerio.client.duration"].Unit)
+
+		_, ok = metrics["customerio.client.errors"]
+		assert.False(t, ok)
+	})
+}
+
+// TestErrorType will test the method errorType()
+func TestErrorType(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "canceled", errorType(context.Canceled))
+	assert.Equal(t, "deadline_exceeded", errorType(context.DeadlineExceeded))
+	assert.Equal(t, "transport_error", errorType(errors.New("connection refused")))
+}
@@ -0,0 +1,160 @@
+package customerio
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// ListOptions are the cursor pagination options for App API list endpoints
+type ListOptions struct {
+	Limit int    // Limit is the max number of results per page (the API max varies per endpoint)
+	Start string // Start is the cursor returned as "next" from the previous page
+}
+
+// values will return the query parameters for the options
+func (l *ListOptions) values() url.Values {
+	v := url.Values{}
+	if l == nil {
+		return v
+	}
+	if l.Limit > 0 {
+		v.Set("limit", strconv.Itoa(l.Limit))
+	}
+	if l.Start != "" {
+		v.Set("start", l.Start)
+	}
+	return v
+}
+
+// withQuery will add the query parameters (if any) to the URL
+func withQuery(requestURL string, values url.Values) string {
+	if len(values) == 0 {
+		return requestURL
+	}
+	return requestURL + "?" + values.Encode()
+}
+
+// PageFunc will fetch a single page of results using the options
+// and return the items and the cursor for the next page (empty if there are no more pages)
+type PageFunc[T any] func(ctx context.Context, opts *ListOptions) ([]T, string, error)
+
+// paginatorOptions holds all the configuration for a paginator
+type paginatorOptions struct {
+	maxItems int // Max number of items to return (0 is unlimited)
+	maxPages int // Max number of pages to fetch (0 is unlimited)
+	pageSize int // Number of items per page (0 uses the API default)
+}
+
+// PaginatorOps allow functional options to be supplied
+// that overwrite default paginator options.
+type PaginatorOps func(p *paginatorOptions)
+
+// WithMaxItems will limit the total number of items returned.
+// Default is unlimited.
+func WithMaxItems(maxItems int) PaginatorOps {
+	return func(p *paginatorOptions) {
+		p.maxItems = maxItems
+	}
+}
+
+// WithMaxPages will limit the number of pages fetched.
+// Default is unlimited.
+func WithMaxPages(maxPages int) PaginatorOps {
+	return func(p *paginatorOptions) {
+		p.maxPages = maxPages
+	}
+}
+
+// WithPageSize will set the number of items requested per page.
+// Default is the API default for the endpoint.
+func WithPageSize(pageSize int) PaginatorOps {
+	return func(p *paginatorOptions) {
+		p.pageSize = pageSize
+	}
+}
+
+// Paginator will transparently follow the "next" cursors of an App API list endpoint
+//
+// A Paginator is not safe for concurrent use
+type Paginator[T any] struct {
+	done    bool
+	fetch   PageFunc[T]
+	items   int
+	next    string
+	options *paginatorOptions
+	pages   int
+}
+
+// NewPaginator will create a new paginator using the page function
+func NewPaginator[T any](fetch PageFunc[T], opts ...PaginatorOps) *Paginator[T] {
+	options := new(paginatorOptions)
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &Paginator[T]{
+		fetch:   fetch,
+		options: options,
+	}
+}
+
+// HasMore will return true if there are more pages to fetch
+func (p *Paginator[T]) HasMore() bool {
+	if p.done {
+		return false
+	} else if p.options.maxPages > 0 && p.pages >= p.options.maxPages {
+		return false
+	} else if p.options.maxItems > 0 && p.items >= p.options.maxItems {
+		return false
+	}
+	return true
+}
+
+// Next will fetch the next page of items
+// Returns nil (and no error) once there are no more pages
+func (p *Paginator[T]) Next(ctx context.Context) ([]T, error) {
+	if !p.HasMore() {
+		return nil, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Do not request more than needed
+	opts := &ListOptions{Limit: p.options.pageSize, Start: p.next}
+	if remaining := p.options.maxItems - p.items; p.options.maxItems > 0 && opts.Limit > remaining {
+		opts.Limit = remaining
+	}
+
+	items, next, err := p.fetch(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	p.pages++
+
+	// Stop if there is no cursor (or the same cursor is returned)
+	if next == "" || next == p.next {
+		p.done = true
+	}
+	p.next = next
+
+	// Trim to the max items
+	if remaining := p.options.maxItems - p.items; p.options.maxItems > 0 && len(items) > remaining {
+		items = items[:remaining]
+	}
+	p.items += len(items)
+	return items, nil
+}
+
+// All will fetch all the remaining pages and return all the items
+func (p *Paginator[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for p.HasMore() {
+		items, err := p.Next(ctx)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, items...)
+	}
+	return all, nil
+}
@@ -0,0 +1,34 @@
+//go:build go1.23
+
+package customerio
+
+import (
+	"context"
+	"iter"
+)
+
+// Items will return an iterator over all the remaining items (range-over-func)
+// Iteration stops after the first error, which is yielded with the zero value of T
+//
+//	for item, err := range paginator.Items(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//	}
+func (p *Paginator[T]) Items(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.HasMore() {
+			items, err := p.Next(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
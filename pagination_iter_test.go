@@ -0,0 +1,52 @@
+//go:build go1.23
+
+package customerio
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPaginator_Items will test the method Items()
+func TestPaginator_Items(t *testing.T) {
+	t.Parallel()
+
+	items := []int{1, 2, 3, 4, 5, 6, 7}
+
+	t.Run("all items", func(t *testing.T) {
+		var calls []ListOptions
+		var all []int
+		for item, err := range NewPaginator(testPageFunc(items, 3, &calls)).Items(context.Background()) {
+			assert.NoError(t, err)
+			all = append(all, item)
+		}
+		assert.Equal(t, items, all)
+		assert.Len(t, calls, 3)
+	})
+
+	t.Run("break early", func(t *testing.T) {
+		var calls []ListOptions
+		for item := range NewPaginator(testPageFunc(items, 3, &calls)).Items(context.Background()) {
+			if item == 2 {
+				break
+			}
+		}
+		assert.Len(t, calls, 1)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		testErr := errors.New("failed")
+		p := NewPaginator(func(_ context.Context, _ *ListOptions) ([]int, string, error) {
+			return nil, "", testErr
+		})
+		var errs int
+		for _, err := range p.Items(context.Background()) {
+			assert.ErrorIs(t, err, testErr)
+			errs++
+		}
+		assert.Equal(t, 1, errs)
+	})
+}
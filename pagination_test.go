@@ -0,0 +1,193 @@
+package customerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// testPageFunc will return a page function over the items (pages of pageSize, or the limit if set)
+func testPageFunc(items []int, pageSize int, calls *[]ListOptions) PageFunc[int] {
+	return func(_ context.Context, opts *ListOptions) ([]int, string, error) {
+		*calls = append(*calls, *opts)
+		start, _ := strconv.Atoi(opts.Start)
+		size := pageSize
+		if opts.Limit > 0 {
+			size = opts.Limit
+		}
+		end := start + size
+		if end >= len(items) {
+			return items[start:], "", nil
+		}
+		return items[start:end], strconv.Itoa(end), nil
+	}
+}
+
+// TestPaginator will test the Paginator
+func TestPaginator(t *testing.T) {
+	t.Parallel()
+
+	items := []int{1, 2, 3, 4, 5, 6, 7}
+
+	t.Run("all pages", func(t *testing.T) {
+		var calls []ListOptions
+		p := NewPaginator(testPageFunc(items, 3, &calls))
+		all, err := p.All(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, items, all)
+		assert.Len(t, calls, 3)
+		assert.Equal(t, "", calls[0].Start)
+		assert.Equal(t, "3", calls[1].Start)
+		assert.False(t, p.HasMore())
+
+		var page []int
+		page, err = p.Next(context.Background())
+		assert.NoError(t, err)
+		assert.Nil(t, page)
+	})
+
+	t.Run("page size", func(t *testing.T) {
+		var calls []ListOptions
+		all, err := NewPaginator(testPageFunc(items, 3, &calls), WithPageSize(5)).All(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, items, all)
+		assert.Len(t, calls, 2)
+		assert.Equal(t, 5, calls[0].Limit)
+	})
+
+	t.Run("max pages", func(t *testing.T) {
+		var calls []ListOptions
+		all, err := NewPaginator(testPageFunc(items, 3, &calls), WithMaxPages(2)).All(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, all)
+		assert.Len(t, calls, 2)
+	})
+
+	t.Run("max items", func(t *testing.T) {
+		var calls []ListOptions
+		all, err := NewPaginator(testPageFunc(items, 3, &calls), WithMaxItems(4)).All(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4}, all)
+		assert.Len(t, calls, 2)
+	})
+
+	t.Run("max items lowers the page size", func(t *testing.T) {
+		var calls []ListOptions
+		all, err := NewPaginator(
+			testPageFunc(items, 3, &calls), WithMaxItems(4), WithPageSize(3),
+		).All(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4}, all)
+		assert.Equal(t, 1, calls[1].Limit)
+	})
+
+	t.Run("repeated cursor", func(t *testing.T) {
+		var calls int
+		p := NewPaginator(func(_ context.Context, _ *ListOptions) ([]int, string, error) {
+			calls++
+			return []int{calls}, "same", nil
+		})
+		all, err := p.All(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, all)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		testErr := errors.New("failed")
+		p := NewPaginator(func(_ context.Context, opts *ListOptions) ([]int, string, error) {
+			if opts.Start != "" {
+				return nil, "", testErr
+			}
+			return []int{1}, "next", nil
+		})
+		all, err := p.All(context.Background())
+		assert.ErrorIs(t, err, testErr)
+		assert.Equal(t, []int{1}, all)
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		var calls []ListOptions
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewPaginator(testPageFunc(items, 3, &calls)).All(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Empty(t, calls)
+	})
+}
+
+// TestClient_SearchCustomersPaginator will test the method SearchCustomersPaginator()
+func TestClient_SearchCustomersPaginator(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("follows cursors", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSearchCustomersPages()
+
+		var results []*CustomerIdentifiers
+		results, err = client.SearchCustomersPaginator(
+			&CustomerFilter{Segment: &SegmentFilter{ID: 7}},
+		).All(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, results, 3)
+		assert.Equal(t, "789", results[2].ID)
+		assert.Equal(t, 2, httpmock.GetTotalCallCount())
+	})
+}
+
+// ExampleNewPaginator example using NewPaginator()
+func ExampleNewPaginator() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockSearchCustomersPages()
+
+	// Page through all the customers in a segment
+	paginator := client.SearchCustomersPaginator(&CustomerFilter{Segment: &SegmentFilter{ID: 7}}, WithMaxPages(10))
+	for paginator.HasMore() {
+		var customers []*CustomerIdentifiers
+		if customers, err = paginator.Next(context.Background()); err != nil {
+			fmt.Printf("error searching customers: %s", err.Error())
+			return
+		}
+		fmt.Printf("found: %d ", len(customers))
+	}
+	// Output:found: 2 found: 1
+}
+
+// BenchmarkPaginator_All benchmarks the method All()
+func BenchmarkPaginator_All(b *testing.B) {
+	items := []int{1, 2, 3, 4, 5, 6, 7}
+	for i := 0; i < b.N; i++ {
+		var calls []ListOptions
+		_, _ = NewPaginator(testPageFunc(items, 3, &calls)).All(context.Background())
+	}
+}
+
+// mockSearchCustomersPages is used for mocking a response with two pages
+func mockSearchCustomersPages() {
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%sv1/customers", testAppAPIURL),
+		httpmock.NewStringResponder(
+			http.StatusOK, `{"identifiers":[{"id":"123"},{"id":"456"}],"next":"abc"}`,
+		),
+	)
+	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%sv1/customers?start=abc", testAppAPIURL),
+		httpmock.NewStringResponder(
+			http.StatusOK, `{"identifiers":[{"id":"789"}],"next":""}`,
+		),
+	)
+}
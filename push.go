@@ -0,0 +1,87 @@
+package customerio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// PushRequest is the request structure for sending a push notification
+type PushRequest struct {
+	CustomData              map[string]string      `json:"custom_data,omitempty"`
+	CustomDevice            *PushCustomDevice      `json:"custom_device,omitempty"`
+	CustomPayload           map[string]interface{} `json:"custom_payload,omitempty"`
+	DisableMessageRetention *bool                  `json:"disable_message_retention,omitempty"`
+	Identifiers             map[string]string      `json:"identifiers"`
+	ImageURL                string                 `json:"image_url,omitempty"`
+	Link                    string                 `json:"link,omitempty"`
+	Message                 string                 `json:"message,omitempty"`
+	MessageData             map[string]interface{} `json:"message_data,omitempty"`
+	QueueDraft              *bool                  `json:"queue_draft,omitempty"`
+	SendToUnsubscribed      *bool                  `json:"send_to_unsubscribed,omitempty"`
+	Sound                   string                 `json:"sound,omitempty"`
+	Title                   string                 `json:"title,omitempty"`
+	To                      string                 `json:"to,omitempty"` // "all", "last_used" or a device token
+	TransactionalMessageID  string                 `json:"transactional_message_id"`
+}
+
+// PushCustomDevice is a device to send the push to (instead of the devices on the customer profile)
+type PushCustomDevice struct {
+	Attributes map[string]string `json:"attributes,omitempty"`
+	LastUsed   int64             `json:"last_used,omitempty"`
+	Platform   DevicePlatform    `json:"platform"`
+	Token      string            `json:"token"`
+}
+
+// SendPush sends a single transactional push notification using the Customer.io transactional API
+// See: https://customer.io/docs/api/app/#operation/sendPush
+func (c *Client) SendPush(pushRequest *PushRequest) (*TransactionalResponse, error) {
+	return c.SendPushWithContext(context.Background(), pushRequest)
+}
+
+// SendPushWithContext is the same as SendPush() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/sendPush
+func (c *Client) SendPushWithContext(ctx context.Context, pushRequest *PushRequest) (*TransactionalResponse, error) {
+
+	// Request cannot be nil, don't panic dude!
+	if pushRequest == nil {
+		return nil, ParamError{Param: "pushRequest"}
+	}
+
+	// A template is always required for push
+	if pushRequest.TransactionalMessageID == "" {
+		return nil, ParamError{Param: "pushTransactionalMessageID"}
+	} else if len(pushRequest.Identifiers) == 0 {
+		return nil, ParamError{Param: "pushIdentifiers"}
+	}
+
+	// Custom device (optional)
+	if pushRequest.CustomDevice != nil {
+		if pushRequest.CustomDevice.Token == "" {
+			return nil, ParamError{Param: "pushCustomDeviceToken"}
+		} else if !acceptedPlatforms(pushRequest.CustomDevice.Platform) {
+			return nil, ParamError{Param: "pushCustomDevicePlatform"}
+		}
+	}
+
+	// Attempt to send the push
+	response, err := c.request(
+		ctx,
+		"SendPush",
+		http.MethodPost,
+		fmt.Sprintf("%s/v1/send/push", c.options.apiURL),
+		pushRequest,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the response
+	var r TransactionalResponse
+	if err = json.Unmarshal(response.Body, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
@@ -0,0 +1,183 @@
+package customerio
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestPushRequest will return a push request for testing
+func newTestPushRequest() *PushRequest {
+	return &PushRequest{
+		Identifiers: map[string]string{"id": testCustomerID},
+		MessageData: map[string]interface{}{
+			"name": "Person",
+		},
+		Title:                  "Your order shipped!",
+		Message:                "Track your package",
+		TransactionalMessageID: "3",
+	}
+}
+
+// TestClient_SendPush will test the method SendPush()
+func TestClient_SendPush(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSendPush(http.StatusOK)
+
+		var resp *TransactionalResponse
+		resp, err = client.SendPush(newTestPushRequest())
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Equal(t, "1234567890", resp.DeliveryID)
+		assert.Equal(t, int64(1620313799), resp.QueuedAt.Unix())
+	})
+
+	t.Run("successful response (custom device)", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSendPush(http.StatusOK)
+
+		req := newTestPushRequest()
+		req.CustomDevice = &PushCustomDevice{Platform: PlatformAndroid, Token: testDeviceID}
+
+		var resp *TransactionalResponse
+		resp, err = client.SendPush(req)
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("missing push request", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		var resp *TransactionalResponse
+		resp, err = client.SendPush(nil)
+		assert.Nil(t, resp)
+		checkParamError(t, err, "pushRequest")
+	})
+
+	t.Run("missing transactional message id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		req := newTestPushRequest()
+		req.TransactionalMessageID = ""
+
+		var resp *TransactionalResponse
+		resp, err = client.SendPush(req)
+		assert.Nil(t, resp)
+		checkParamError(t, err, "pushTransactionalMessageID")
+	})
+
+	t.Run("missing identifiers", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		req := newTestPushRequest()
+		req.Identifiers = nil
+
+		var resp *TransactionalResponse
+		resp, err = client.SendPush(req)
+		assert.Nil(t, resp)
+		checkParamError(t, err, "pushIdentifiers")
+	})
+
+	t.Run("invalid custom device", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		req := newTestPushRequest()
+		req.CustomDevice = &PushCustomDevice{Platform: PlatformIOs}
+		_, err = client.SendPush(req)
+		checkParamError(t, err, "pushCustomDeviceToken")
+
+		req.CustomDevice = &PushCustomDevice{Platform: "windows", Token: testDeviceID}
+		_, err = client.SendPush(req)
+		checkParamError(t, err, "pushCustomDevicePlatform")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		httpmock.Reset()
+		httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%sv1/send/push", testAppAPIURL),
+			httpmock.NewStringResponder(
+				http.StatusBadRequest, `{"meta":{"error":"push notification not found"}}`,
+			),
+		)
+
+		var resp *TransactionalResponse
+		resp, err = client.SendPush(newTestPushRequest())
+		assert.Nil(t, resp)
+
+		var tErr *TransactionalError
+		assert.True(t, errors.As(err, &tErr))
+		assert.Equal(t, http.StatusBadRequest, tErr.StatusCode)
+		assert.Equal(t, "push notification not found", tErr.Error())
+	})
+}
+
+// ExampleClient_SendPush example using SendPush()
+//
+// See more examples in /examples/
+func ExampleClient_SendPush() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockSendPush(http.StatusOK)
+
+	// Send push
+	var resp *TransactionalResponse
+	if resp, err = client.SendPush(&PushRequest{
+		Identifiers:            map[string]string{"id": "123"},
+		TransactionalMessageID: "3",
+	}); err != nil {
+		fmt.Printf("error sending push: %s", err.Error())
+		return
+	}
+	fmt.Printf("push sent: %s", resp.DeliveryID)
+	// Output:push sent: 1234567890
+}
+
+// BenchmarkClient_SendPush benchmarks the method SendPush()
+func BenchmarkClient_SendPush(b *testing.B) {
+	client, _ := newTestClient()
+	mockSendPush(http.StatusOK)
+	req := newTestPushRequest()
+	for i := 0; i < b.N; i++ {
+		_, _ = client.SendPush(req)
+	}
+}
+
+// mockSendPush is used for mocking the response
+func mockSendPush(statusCode int) {
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%sv1/send/push", testAppAPIURL),
+		httpmock.NewStringResponder(
+			statusCode, `{"delivery_id": "1234567890","queued_at": 1620313799}`,
+		),
+	)
+}
@@ -0,0 +1,441 @@
+package customerio
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Errors returned when an operation cannot be added to the queue
+var (
+	ErrQueueClosed = errors.New("queue is closed")
+	ErrQueueFull   = errors.New("queue is full")
+)
+
+// queueOptions holds all the configuration for the queue
+type queueOptions struct {
+	flushInterval time.Duration                       // Max time an operation waits before being sent
+	flushSize     int                                 // Number of operations that triggers a flush
+	maxRetries    int                                 // Max retries for a failed batch request
+	maxRetryWait  time.Duration                       // Max wait between retries
+	nonIdempotent bool                                // Also retry server and transport errors
+	onDropped     func(op *BatchOperation, err error) // Called when an operation is not queued
+	onFailed      func(failed *BatchItemError)        // Called when an operation fails to send
+	queueSize     int                                 // Max number of buffered operations
+	retryWait     time.Duration                       // Initial wait between retries (doubles each attempt)
+	workers       int                                 // Number of workers sending batches
+}
+
+// QueueOps allow functional options to be supplied
+// that overwrite default queue options.
+type QueueOps func(q *queueOptions)
+
+// WithQueueSize will overwrite the max number of buffered operations.
+// Default is 10,000.
+func WithQueueSize(size int) QueueOps {
+	return func(q *queueOptions) {
+		q.queueSize = size
+	}
+}
+
+// WithFlushSize will overwrite the number of operations that triggers a flush.
+// Default is 100.
+func WithFlushSize(size int) QueueOps {
+	return func(q *queueOptions) {
+		q.flushSize = size
+	}
+}
+
+// WithFlushInterval will overwrite how often the queue is flushed.
+// Default is 5 seconds.
+func WithFlushInterval(interval time.Duration) QueueOps {
+	return func(q *queueOptions) {
+		q.flushInterval = interval
+	}
+}
+
+// WithWorkers will overwrite the number of workers sending batches.
+// Default is 2.
+func WithWorkers(workers int) QueueOps {
+	return func(q *queueOptions) {
+		q.workers = workers
+	}
+}
+
+// WithQueueRetries will overwrite the retries and the backoff for failed batch requests.
+// The wait doubles each attempt (with jitter) up to maxWait.
+// Only rate limits (429) are retried, unless WithQueueRetryNonIdempotent() is set.
+// Default is 3 retries, starting at 500ms up to 10 seconds.
+func WithQueueRetries(retries int, wait, maxWait time.Duration) QueueOps {
+	return func(q *queueOptions) {
+		q.maxRetries = retries
+		q.retryWait = wait
+		q.maxRetryWait = maxWait
+	}
+}
+
+// WithQueueRetryNonIdempotent will also retry batches that failed with a server (5xx) or transport error.
+// The batch may have been processed before the failure, so operations are sent at least once
+// (IE: an event can be recorded twice). Default is false.
+func WithQueueRetryNonIdempotent(retry bool) QueueOps {
+	return func(q *queueOptions) {
+		q.nonIdempotent = retry
+	}
+}
+
+// WithOnDropped will set the callback used when an operation is dropped (queue is full or closed)
+func WithOnDropped(fn func(op *BatchOperation, err error)) QueueOps {
+	return func(q *queueOptions) {
+		q.onDropped = fn
+	}
+}
+
+// WithOnFailed will set the callback used when an operation fails to send (after all retries)
+func WithOnFailed(fn func(failed *BatchItemError)) QueueOps {
+	return func(q *queueOptions) {
+		q.onFailed = fn
+	}
+}
+
+// defaultQueueOptions will return a queueOptions struct with the default settings
+func defaultQueueOptions() *queueOptions {
+	return &queueOptions{
+		flushInterval: defaultQueueFlushInterval,
+		flushSize:     defaultQueueFlushSize,
+		maxRetries:    defaultQueueMaxRetries,
+		maxRetryWait:  defaultQueueMaxRetryWait,
+		queueSize:     defaultQueueSize,
+		retryWait:     defaultQueueRetryWait,
+		workers:       defaultQueueWorkers,
+	}
+}
+
+// Queue is a background queue for fire-and-forget tracking
+// Operations are buffered in memory and sent using the batch endpoint by a pool of workers,
+// either when the flush size is reached or on the flush interval
+//
+// A Queue is safe for concurrent use
+type Queue struct {
+	batches  chan []*BatchOperation
+	cancel   context.CancelFunc
+	client   *Client
+	closed   bool
+	ctx      context.Context
+	done     chan struct{}
+	flush    chan chan struct{}
+	inflight sync.WaitGroup
+	mu       sync.RWMutex
+	ops      chan *BatchOperation
+	options  *queueOptions
+	quit     chan struct{}
+	workers  sync.WaitGroup
+}
+
+// NewQueue will start a new background queue using the client
+//
+// Always call Close() to send any remaining operations before shutting down
+func (c *Client) NewQueue(opts ...QueueOps) *Queue {
+	options := defaultQueueOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.flushInterval <= 0 {
+		options.flushInterval = defaultQueueFlushInterval
+	}
+	if options.flushSize <= 0 {
+		options.flushSize = defaultQueueFlushSize
+	}
+	if options.workers <= 0 {
+		options.workers = defaultQueueWorkers
+	}
+	if options.queueSize < 0 {
+		options.queueSize = 0
+	}
+
+	q := &Queue{
+		batches: make(chan []*BatchOperation),
+		client:  c,
+		done:    make(chan struct{}),
+		flush:   make(chan chan struct{}),
+		ops:     make(chan *BatchOperation, options.queueSize),
+		options: options,
+		quit:    make(chan struct{}),
+	}
+	q.ctx, q.cancel = context.WithCancel(context.Background())
+
+	// Start the workers and the dispatcher
+	for i := 0; i < options.workers; i++ {
+		q.workers.Add(1)
+		go q.worker()
+	}
+	go q.dispatch()
+
+	return q
+}
+
+// NewEvent will queue a new event for the supplied customer
+// See: NewEvent() for the parameters
+func (q *Queue) NewEvent(customerIDOrEmail string, eventName string, timestamp time.Time,
+	data map[string]interface{}) error {
+	if customerIDOrEmail == "" {
+		return ParamError{Param: "customerIDOrEmail"}
+	}
+	if eventName == "" {
+		return ParamError{Param: "eventName"}
+	}
+	if timestamp.IsZero() {
+		timestamp = time.Now().UTC()
+	}
+	return q.Add(&BatchOperation{
+		Action:      BatchActionEvent,
+		Attributes:  data,
+		Identifiers: customerIdentifier(customerIDOrEmail).Map(),
+		Name:        eventName,
+		Timestamp:   timestamp.Unix(),
+		Type:        batchEntityTypePerson,
+	})
+}
+
+// UpdateCustomer will queue an add/update for a customer and their attributes
+// See: UpdateCustomer() for the parameters
+func (q *Queue) UpdateCustomer(customerIDOrEmail string, attributes map[string]interface{}) error {
+	if customerIDOrEmail == "" {
+		return ParamError{Param: "customerIDOrEmail"}
+	}
+	return q.Add(&BatchOperation{
+		Action:      BatchActionIdentify,
+		Attributes:  attributes,
+		Identifiers: customerIdentifier(customerIDOrEmail).Map(),
+		Type:        batchEntityTypePerson,
+	})
+}
+
+// Add will queue a raw batch operation
+// This never blocks: if the queue is full or closed, the operation is dropped and an error is returned
+func (q *Queue) Add(operation *BatchOperation) error {
+	if err := validateBatchOperation(operation); err != nil {
+		return err
+	}
+
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		q.dropped(operation, ErrQueueClosed)
+		return ErrQueueClosed
+	}
+	select {
+	case q.ops <- operation:
+		return nil
+	default:
+		q.dropped(operation, ErrQueueFull)
+		return ErrQueueFull
+	}
+}
+
+// Flush will send all queued operations and wait until they are processed
+func (q *Queue) Flush(ctx context.Context) error {
+	done := make(chan struct{})
+	select {
+	case q.flush <- done:
+	case <-q.done:
+		return ErrQueueClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close will stop accepting operations, send any remaining operations and stop the workers
+// If the context is done before everything is sent, in-flight requests are canceled
+func (q *Queue) Close(ctx context.Context) error {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return ErrQueueClosed
+	}
+	q.closed = true
+	q.mu.Unlock()
+
+	close(q.quit)
+	select {
+	case <-q.done:
+		q.cancel()
+		return nil
+	case <-ctx.Done():
+		q.cancel()
+		<-q.done
+		return ctx.Err()
+	}
+}
+
+// dispatch will collect operations and hand them off to the workers
+func (q *Queue) dispatch() {
+	ticker := time.NewTicker(q.options.flushInterval)
+	defer ticker.Stop()
+
+	var pending []*BatchOperation
+
+	// send will hand off the pending operations to a worker
+	send := func() {
+		if len(pending) == 0 {
+			return
+		}
+		q.inflight.Add(1)
+		q.batches <- pending
+		pending = nil
+	}
+
+	// add will append an operation and send if the flush size is reached
+	add := func(op *BatchOperation) {
+		pending = append(pending, op)
+		if len(pending) >= q.options.flushSize {
+			send()
+		}
+	}
+
+	// drain will add all the buffered operations
+	drain := func() {
+		for {
+			select {
+			case op := <-q.ops:
+				add(op)
+			default:
+				send()
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case op := <-q.ops:
+			add(op)
+		case <-ticker.C:
+			send()
+		case done := <-q.flush:
+			drain()
+			q.inflight.Wait()
+			close(done)
+		case <-q.quit:
+			drain()
+			close(q.batches)
+			q.workers.Wait()
+			close(q.done)
+			return
+		}
+	}
+}
+
+// worker will send batches until the queue is closed
+func (q *Queue) worker() {
+	defer q.workers.Done()
+	for ops := range q.batches {
+		q.send(ops)
+		q.inflight.Done()
+	}
+}
+
+// send will send the operations, retrying transient failures with backoff
+func (q *Queue) send(ops []*BatchOperation) {
+	for attempt := 0; ; attempt++ {
+		batch := q.client.NewBatch()
+		batch.operations = ops
+
+		result, err := batch.SendWithContext(q.ctx)
+		if err == nil || attempt >= q.options.maxRetries || !retryableError(err, q.options.nonIdempotent) {
+			for _, failed := range result.Failed {
+				q.failed(failed)
+			}
+			return
+		}
+
+		// Only retry the operations that failed with a request (not the ones rejected by the API)
+		var retry []*BatchOperation
+		for _, failed := range result.Failed {
+			if retryableError(failed.Err, q.options.nonIdempotent) {
+				retry = append(retry, failed.Operation)
+			} else {
+				q.failed(failed)
+			}
+		}
+		ops = retry
+
+		// Wait (exponential backoff with jitter) unless the queue is canceled
+		timer := time.NewTimer(backoff(q.options.retryWait, q.options.maxRetryWait, attempt))
+		select {
+		case <-timer.C:
+		case <-q.ctx.Done():
+			timer.Stop()
+			for i, op := range ops {
+				q.failed(&BatchItemError{Err: q.ctx.Err(), Index: i, Operation: op})
+			}
+			return
+		}
+	}
+}
+
+// dropped will fire the dropped callback if set
+func (q *Queue) dropped(op *BatchOperation, err error) {
+	if q.options.onDropped != nil {
+		q.options.onDropped(op, err)
+	}
+}
+
+// failed will fire the failed callback if set
+func (q *Queue) failed(failed *BatchItemError) {
+	if q.options.onFailed != nil {
+		q.options.onFailed(failed)
+	}
+}
+
+// retryableError will return true if the batch request can be retried
+// Rate limits are always retried (the batch was not processed), server and transport errors
+// are only retried if nonIdempotent is set (the batch may have been processed)
+// A canceled context or a rejected operation is never retried
+func retryableError(err error, nonIdempotent bool) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, ErrBatchOperationRejected) || errors.Is(err, ErrBatchOperationTooLarge) {
+		return false
+	}
+	if errors.Is(err, ErrRateLimited) {
+		return true
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && !errors.Is(err, ErrServer) {
+		return false
+	}
+	return nonIdempotent
+}
+
+// backoff will return the exponential wait for the attempt (with full jitter)
+func backoff(wait, maxWait time.Duration, attempt int) time.Duration {
+	d := exponentialWait(wait, maxWait, attempt)
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(d)) + 1) //nolint:gosec // jitter does not need crypto/rand
+}
+
+// exponentialWait will return wait doubled for each attempt, capped at maxWait (if set)
+// The wait never overflows, it is capped at the max duration
+func exponentialWait(wait, maxWait time.Duration, attempt int) time.Duration {
+	if wait <= 0 {
+		return 0
+	}
+	d := wait
+	for i := 0; i < attempt && d <= math.MaxInt64/2; i++ {
+		d *= 2
+	}
+	if maxWait > 0 && d > maxWait {
+		d = maxWait
+	}
+	return d
+}
@@ -0,0 +1,333 @@
+package customerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestClient_NewQueue will test the method NewQueue()
+func TestClient_NewQueue(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("flush by size", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockBatch(http.StatusOK, "")
+
+		queue := client.NewQueue(WithFlushSize(2), WithFlushInterval(time.Hour))
+		for i := 0; i < 4; i++ {
+			assert.NoError(t, queue.NewEvent(testCustomerID, testEventName, time.Time{}, nil))
+		}
+		assert.NoError(t, queue.Flush(context.Background()))
+		assert.Equal(t, 2, httpmock.GetTotalCallCount())
+		assert.NoError(t, queue.Close(context.Background()))
+	})
+
+	t.Run("flush by interval", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockBatch(http.StatusOK, "")
+
+		queue := client.NewQueue(WithFlushInterval(10 * time.Millisecond))
+		assert.NoError(t, queue.UpdateCustomer(testCustomerEmail, map[string]interface{}{"plan": "basic"}))
+		assert.Eventually(t, func() bool {
+			return httpmock.GetTotalCallCount() == 1
+		}, time.Second, 5*time.Millisecond)
+		assert.NoError(t, queue.Close(context.Background()))
+	})
+
+	t.Run("invalid options use the defaults", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		queue := client.NewQueue(WithFlushInterval(0), WithFlushSize(-1), WithWorkers(0), WithQueueSize(-1))
+		assert.Equal(t, defaultQueueFlushInterval, queue.options.flushInterval)
+		assert.Equal(t, defaultQueueFlushSize, queue.options.flushSize)
+		assert.Equal(t, defaultQueueWorkers, queue.options.workers)
+		assert.Equal(t, 0, queue.options.queueSize)
+		assert.NoError(t, queue.Close(context.Background()))
+
+		queue = client.NewQueue(WithFlushInterval(-time.Second))
+		assert.Equal(t, defaultQueueFlushInterval, queue.options.flushInterval)
+		assert.NoError(t, queue.Close(context.Background()))
+	})
+
+	t.Run("close sends remaining operations", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockBatch(http.StatusOK, "")
+
+		var dropped int32
+		queue := client.NewQueue(
+			WithFlushInterval(time.Hour),
+			WithOnDropped(func(_ *BatchOperation, err error) {
+				assert.True(t, errors.Is(err, ErrQueueClosed))
+				atomic.AddInt32(&dropped, 1)
+			}),
+		)
+		assert.NoError(t, queue.NewEvent(testCustomerID, testEventName, time.Time{}, nil))
+		assert.NoError(t, queue.Close(context.Background()))
+		assert.Equal(t, 1, httpmock.GetTotalCallCount())
+
+		err = queue.NewEvent(testCustomerID, testEventName, time.Time{}, nil)
+		assert.True(t, errors.Is(err, ErrQueueClosed))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&dropped))
+		assert.True(t, errors.Is(queue.Flush(context.Background()), ErrQueueClosed))
+		assert.True(t, errors.Is(queue.Close(context.Background()), ErrQueueClosed))
+	})
+
+	t.Run("rate limit is retried", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		calls := mockQueueRetry(http.StatusTooManyRequests)
+
+		var failed int32
+		queue := client.NewQueue(
+			WithQueueRetries(2, time.Millisecond, 5*time.Millisecond),
+			WithOnFailed(func(_ *BatchItemError) {
+				atomic.AddInt32(&failed, 1)
+			}),
+		)
+		assert.NoError(t, queue.NewEvent(testCustomerID, testEventName, time.Time{}, nil))
+		assert.NoError(t, queue.Close(context.Background()))
+		assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+		assert.Equal(t, int32(0), atomic.LoadInt32(&failed))
+	})
+
+	t.Run("server error is not retried", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		calls := mockQueueRetry(http.StatusServiceUnavailable)
+
+		var failed int32
+		queue := client.NewQueue(
+			WithQueueRetries(2, time.Millisecond, 5*time.Millisecond),
+			WithOnFailed(func(_ *BatchItemError) {
+				atomic.AddInt32(&failed, 1)
+			}),
+		)
+		assert.NoError(t, queue.NewEvent(testCustomerID, testEventName, time.Time{}, nil))
+		assert.NoError(t, queue.Close(context.Background()))
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&failed))
+	})
+
+	t.Run("server error is retried if non-idempotent is allowed", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		calls := mockQueueRetry(http.StatusServiceUnavailable)
+
+		var failed int32
+		queue := client.NewQueue(
+			WithQueueRetries(2, time.Millisecond, 5*time.Millisecond),
+			WithQueueRetryNonIdempotent(true),
+			WithOnFailed(func(_ *BatchItemError) {
+				atomic.AddInt32(&failed, 1)
+			}),
+		)
+		assert.NoError(t, queue.NewEvent(testCustomerID, testEventName, time.Time{}, nil))
+		assert.NoError(t, queue.Close(context.Background()))
+		assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+		assert.Equal(t, int32(0), atomic.LoadInt32(&failed))
+	})
+
+	t.Run("failed operations are not retried", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockBatch(http.StatusBadRequest, `{"meta":{"error":"bad request"}}`)
+
+		var mu sync.Mutex
+		var failures []*BatchItemError
+		queue := client.NewQueue(
+			WithQueueRetries(2, time.Millisecond, 5*time.Millisecond),
+			WithOnFailed(func(failed *BatchItemError) {
+				mu.Lock()
+				failures = append(failures, failed)
+				mu.Unlock()
+			}),
+		)
+		assert.NoError(t, queue.UpdateCustomer(testCustomerID, nil))
+		assert.NoError(t, queue.Close(context.Background()))
+		assert.Equal(t, 1, httpmock.GetTotalCallCount())
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Len(t, failures, 1)
+		assert.Equal(t, testCustomerID, failures[0].Operation.Identifiers["id"])
+	})
+
+	t.Run("queue is full", func(t *testing.T) {
+		var dropped int32
+		queue := &Queue{
+			ops: make(chan *BatchOperation, 1),
+			options: &queueOptions{
+				onDropped: func(_ *BatchOperation, _ error) {
+					atomic.AddInt32(&dropped, 1)
+				},
+			},
+		}
+		assert.NoError(t, queue.UpdateCustomer(testCustomerID, nil))
+		assert.True(t, errors.Is(queue.UpdateCustomer(testCustomerID, nil), ErrQueueFull))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&dropped))
+	})
+
+	t.Run("invalid operations", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		queue := client.NewQueue()
+		checkParamError(t, queue.NewEvent("", testEventName, time.Time{}, nil), "customerIDOrEmail")
+		checkParamError(t, queue.NewEvent(testCustomerID, "", time.Time{}, nil), "eventName")
+		checkParamError(t, queue.UpdateCustomer("", nil), "customerIDOrEmail")
+		checkParamError(t, queue.Add(nil), "operation")
+		assert.NoError(t, queue.Close(context.Background()))
+	})
+}
+
+// TestRetryableError will test the method retryableError()
+func TestRetryableError(t *testing.T) {
+	t.Parallel()
+
+	rateLimited := &APIError{status: http.StatusTooManyRequests}
+	server := &APIError{status: http.StatusServiceUnavailable}
+	badRequest := &APIError{status: http.StatusBadRequest}
+	transport := errors.New("connection reset")
+
+	var tests = []struct {
+		err           error
+		nonIdempotent bool
+		expected      bool
+	}{
+		{rateLimited, false, true},
+		{rateLimited, true, true},
+		{server, false, false},
+		{server, true, true},
+		{transport, false, false},
+		{transport, true, true},
+		{badRequest, true, false},
+		{ErrBatchOperationRejected, true, false},
+		{context.Canceled, true, false},
+		{context.DeadlineExceeded, true, false},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, retryableError(test.err, test.nonIdempotent), test.err.Error())
+	}
+}
+
+// TestBackoff will test the method backoff()
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Duration(0), backoff(0, time.Second, 3))
+	for attempt := 0; attempt < 10; attempt++ {
+		d := backoff(100*time.Millisecond, time.Second, attempt)
+		assert.Greater(t, d, time.Duration(0))
+		assert.LessOrEqual(t, d, time.Second)
+	}
+
+	t.Run("no max wait never overflows", func(t *testing.T) {
+		for attempt := 0; attempt < 100; attempt++ {
+			assert.NotPanics(t, func() {
+				assert.Greater(t, backoff(time.Second, 0, attempt), time.Duration(0))
+			})
+		}
+	})
+}
+
+// TestExponentialWait will test the method exponentialWait()
+func TestExponentialWait(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Duration(0), exponentialWait(0, time.Second, 3))
+	assert.Equal(t, 100*time.Millisecond, exponentialWait(100*time.Millisecond, time.Second, 0))
+	assert.Equal(t, 400*time.Millisecond, exponentialWait(100*time.Millisecond, time.Second, 2))
+	assert.Equal(t, time.Second, exponentialWait(100*time.Millisecond, time.Second, 10))
+	assert.Equal(t, time.Second, exponentialWait(100*time.Millisecond, time.Second, 1000))
+
+	// No max wait: capped at the max duration instead of overflowing
+	for attempt := 30; attempt < 100; attempt++ {
+		d := exponentialWait(time.Second, 0, attempt)
+		assert.Greater(t, d, time.Duration(0))
+		assert.GreaterOrEqual(t, d, exponentialWait(time.Second, 0, attempt-1))
+	}
+}
+
+// TestCustomerIdentifier will test the method customerIdentifier()
+func TestCustomerIdentifier(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, IdentifierID(testCustomerID), customerIdentifier(testCustomerID))
+	assert.Equal(t, IdentifierEmail(testCustomerEmail), customerIdentifier(testCustomerEmail))
+}
+
+// ExampleClient_NewQueue example using NewQueue()
+//
+// See more examples in /examples/
+func ExampleClient_NewQueue() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockBatch(http.StatusOK, "")
+
+	// Start the queue
+	queue := client.NewQueue()
+
+	// Queue an event (does not block)
+	if err = queue.NewEvent(testCustomerID, testEventName, time.Now().UTC(), nil); err != nil {
+		fmt.Printf("error queueing event: %s", err.Error())
+		return
+	}
+
+	// Send any remaining events
+	if err = queue.Close(context.Background()); err != nil {
+		fmt.Printf("error closing queue: %s", err.Error())
+		return
+	}
+	fmt.Printf("event sent: %s", testEventName)
+	// Output:event sent: test_event
+}
+
+// mockQueueRetry will fail the first batch request with the status code (the next requests succeed)
+func mockQueueRetry(statusCode int) *int32 {
+	var calls int32
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%sapi/v2/batch", testTrackingAPIURL),
+		func(_ *http.Request) (*http.Response, error) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				return httpmock.NewStringResponse(statusCode, ""), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		},
+	)
+	return &calls
+}
@@ -0,0 +1,138 @@
+package customerio
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimit is the client-side limit for an API family
+type RateLimit struct {
+	Burst             int     // Burst is the max number of requests sent at once (defaults to 1)
+	RequestsPerSecond float64 // RequestsPerSecond is the sustained rate (0 is unlimited)
+}
+
+// RateLimits are the client-side limits for each API family (separate token buckets)
+type RateLimits struct {
+	App           RateLimit                                  // App API (and Beta API)
+	OnWait        func(family APIFamily, wait time.Duration) // OnWait is called when a request has to wait (optional)
+	Track         RateLimit                                  // Track API
+	Transactional RateLimit                                  // Transactional API (email, push, sms)
+}
+
+// DefaultRateLimits will return the limits documented by Customer.io
+// Track: 100 req/s, App: 10 req/s, Transactional: 100 req/s
+// See: https://customer.io/docs/api/track/#section/Limits
+func DefaultRateLimits() RateLimits {
+	return RateLimits{
+		App:           RateLimit{Burst: defaultRateLimitApp, RequestsPerSecond: defaultRateLimitApp},
+		Track:         RateLimit{Burst: defaultRateLimitTrack, RequestsPerSecond: defaultRateLimitTrack},
+		Transactional: RateLimit{Burst: defaultRateLimitTransactional, RequestsPerSecond: defaultRateLimitTransactional},
+	}
+}
+
+// rateLimiter holds a token bucket per API family
+type rateLimiter struct {
+	buckets map[APIFamily]*tokenBucket
+	onWait  func(family APIFamily, wait time.Duration)
+}
+
+// newRateLimiter will create a rate limiter using the limits (unlimited families have no bucket)
+func newRateLimiter(limits RateLimits) *rateLimiter {
+	r := &rateLimiter{
+		buckets: make(map[APIFamily]*tokenBucket),
+		onWait:  limits.OnWait,
+	}
+	for family, limit := range map[APIFamily]RateLimit{
+		APIFamilyApp:           limits.App,
+		APIFamilyTrack:         limits.Track,
+		APIFamilyTransactional: limits.Transactional,
+	} {
+		if limit.RequestsPerSecond > 0 {
+			r.buckets[family] = newTokenBucket(limit)
+		}
+	}
+
+	// The Beta API shares the App API limit
+	if bucket, ok := r.buckets[APIFamilyApp]; ok {
+		r.buckets[APIFamilyBeta] = bucket
+	}
+	return r
+}
+
+// wait will block until a request to the API family is allowed or the context is done
+func (r *rateLimiter) wait(ctx context.Context, family APIFamily) error {
+	if r == nil {
+		return nil
+	}
+	bucket, ok := r.buckets[family]
+	if !ok {
+		return nil
+	}
+	d := bucket.reserve(time.Now())
+	if d <= 0 {
+		return nil
+	}
+	if r.onWait != nil {
+		r.onWait(family, d)
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		bucket.cancel()
+		return ctx.Err()
+	}
+}
+
+// tokenBucket is a token bucket that is safe for concurrent use
+type tokenBucket struct {
+	burst  float64
+	last   time.Time
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+}
+
+// newTokenBucket will create a full token bucket using the limit
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		burst:  burst,
+		rate:   limit.RequestsPerSecond,
+		tokens: burst,
+	}
+}
+
+// reserve will take a token and return the wait until it is available
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Refill the bucket since the last reservation
+	if !b.last.IsZero() && now.After(b.last) {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	if now.After(b.last) {
+		b.last = now
+	}
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel will return a reserved token (the request was never sent)
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = min(b.burst, b.tokens+1)
+}
@@ -0,0 +1,182 @@
+package customerio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWithRateLimit will test the method WithRateLimit()
+func TestWithRateLimit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default is no rate limit", func(t *testing.T) {
+		client, err := NewClient(WithTrackingKey(testSiteID, testTrackingAPIKey))
+		assert.NoError(t, err)
+		assert.Nil(t, client.options.rateLimiter)
+	})
+
+	t.Run("default rate limits", func(t *testing.T) {
+		client, err := NewClient(WithTrackingKey(testSiteID, testTrackingAPIKey), WithRateLimit(DefaultRateLimits()))
+		assert.NoError(t, err)
+		assert.NotNil(t, client.options.rateLimiter)
+		assert.Len(t, client.options.rateLimiter.buckets, 4)
+		assert.Same(t, client.options.rateLimiter.buckets[APIFamilyApp], client.options.rateLimiter.buckets[APIFamilyBeta])
+		assert.Equal(t, float64(defaultRateLimitTrack), client.options.rateLimiter.buckets[APIFamilyTrack].rate)
+		assert.Equal(t, float64(defaultRateLimitApp), client.options.rateLimiter.buckets[APIFamilyApp].rate)
+	})
+
+	t.Run("unlimited family has no bucket", func(t *testing.T) {
+		client, err := NewClient(
+			WithTrackingKey(testSiteID, testTrackingAPIKey),
+			WithRateLimit(RateLimits{Track: RateLimit{RequestsPerSecond: 1}}),
+		)
+		assert.NoError(t, err)
+		assert.Len(t, client.options.rateLimiter.buckets, 1)
+		assert.NotNil(t, client.options.rateLimiter.buckets[APIFamilyTrack])
+	})
+}
+
+// TestClient_apiFamily will test the method apiFamily()
+func TestClient_apiFamily(t *testing.T) {
+	t.Parallel()
+
+	client, err := NewClient(WithTrackingKey(testSiteID, testTrackingAPIKey))
+	assert.NoError(t, err)
+
+	var tests = []struct {
+		requestURL string
+		expected   APIFamily
+	}{
+		{RegionUS.trackURL + "/api/v1/customers/123", APIFamilyTrack},
+		{RegionUS.apiURL + "/v1/send/email", APIFamilyTransactional},
+		{RegionUS.apiURL + "/v1/send/push", APIFamilyTransactional},
+		{RegionUS.apiURL + "/v1/segments", APIFamilyApp},
+		{RegionUS.betaURL + "/v1/api/customers", APIFamilyBeta},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, client.apiFamily(test.requestURL), test.requestURL)
+	}
+}
+
+// TestTokenBucket will test the token bucket
+func TestTokenBucket(t *testing.T) {
+	t.Parallel()
+
+	t.Run("burst then wait", func(t *testing.T) {
+		now := time.Now()
+		bucket := newTokenBucket(RateLimit{Burst: 2, RequestsPerSecond: 10})
+		assert.Equal(t, time.Duration(0), bucket.reserve(now))
+		assert.Equal(t, time.Duration(0), bucket.reserve(now))
+		assert.Equal(t, 100*time.Millisecond, bucket.reserve(now))
+		assert.Equal(t, 200*time.Millisecond, bucket.reserve(now))
+	})
+
+	t.Run("refill over time", func(t *testing.T) {
+		now := time.Now()
+		bucket := newTokenBucket(RateLimit{RequestsPerSecond: 10})
+		assert.Equal(t, time.Duration(0), bucket.reserve(now))
+		assert.Equal(t, 100*time.Millisecond, bucket.reserve(now))
+		assert.Equal(t, time.Duration(0), bucket.reserve(now.Add(300*time.Millisecond)))
+	})
+
+	t.Run("refill is capped at burst", func(t *testing.T) {
+		now := time.Now()
+		bucket := newTokenBucket(RateLimit{Burst: 1, RequestsPerSecond: 10})
+		assert.Equal(t, time.Duration(0), bucket.reserve(now))
+		later := now.Add(time.Hour)
+		assert.Equal(t, time.Duration(0), bucket.reserve(later))
+		assert.Equal(t, 100*time.Millisecond, bucket.reserve(later))
+	})
+
+	t.Run("cancel returns the token", func(t *testing.T) {
+		now := time.Now()
+		bucket := newTokenBucket(RateLimit{RequestsPerSecond: 10})
+		assert.Equal(t, time.Duration(0), bucket.reserve(now))
+		assert.Equal(t, 100*time.Millisecond, bucket.reserve(now))
+		bucket.cancel()
+		assert.Equal(t, 100*time.Millisecond, bucket.reserve(now))
+	})
+}
+
+// TestRateLimiter_wait will test the method wait()
+func TestRateLimiter_wait(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil limiter", func(t *testing.T) {
+		var limiter *rateLimiter
+		assert.NoError(t, limiter.wait(context.Background(), APIFamilyTrack))
+	})
+
+	t.Run("families are limited separately", func(t *testing.T) {
+		var mu sync.Mutex
+		waits := make(map[APIFamily]int)
+		limiter := newRateLimiter(RateLimits{
+			App:   RateLimit{RequestsPerSecond: 100},
+			Track: RateLimit{RequestsPerSecond: 100},
+			OnWait: func(family APIFamily, wait time.Duration) {
+				mu.Lock()
+				defer mu.Unlock()
+				waits[family]++
+			},
+		})
+		assert.NoError(t, limiter.wait(context.Background(), APIFamilyTrack))
+		assert.NoError(t, limiter.wait(context.Background(), APIFamilyApp))
+		assert.NoError(t, limiter.wait(context.Background(), APIFamilyTransactional))
+		assert.Empty(t, waits)
+
+		assert.NoError(t, limiter.wait(context.Background(), APIFamilyTrack))
+		assert.Equal(t, 1, waits[APIFamilyTrack])
+		assert.Equal(t, 0, waits[APIFamilyApp])
+	})
+
+	t.Run("context done while waiting", func(t *testing.T) {
+		limiter := newRateLimiter(RateLimits{Track: RateLimit{RequestsPerSecond: 0.1}})
+		assert.NoError(t, limiter.wait(context.Background(), APIFamilyTrack))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+		err := limiter.wait(ctx, APIFamilyTrack)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+}
+
+// TestClient_requestRateLimit will test the method request() using a rate limit
+func TestClient_requestRateLimit(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	client, err := newTestClient()
+	assert.NoError(t, err)
+
+	var waited time.Duration
+	client.options.rateLimiter = newRateLimiter(RateLimits{
+		Track: RateLimit{RequestsPerSecond: 50},
+		OnWait: func(family APIFamily, wait time.Duration) {
+			assert.Equal(t, APIFamilyTrack, family)
+			waited += wait
+		},
+	})
+
+	mockTestAuth(http.StatusOK)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"auth", nil)
+		assert.NoError(t, err)
+	}
+	assert.Greater(t, waited, time.Duration(0))
+	assert.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+}
+
+// BenchmarkTokenBucket_reserve benchmarks the method reserve()
+func BenchmarkTokenBucket_reserve(b *testing.B) {
+	bucket := newTokenBucket(RateLimit{Burst: 100, RequestsPerSecond: 1e9})
+	for i := 0; i < b.N; i++ {
+		_ = bucket.reserve(time.Now())
+	}
+}
@@ -0,0 +1,100 @@
+package customerio
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RetryPolicy controls how failed requests are retried
+//
+// Rate limits (429) are always retried, the request was not processed.
+// Server errors (5xx) and transport errors are only retried for idempotent requests
+// (GET, PUT and DELETE) unless RetryNonIdempotent is set, as a POST (IE: an event) may have been processed.
+// If the response has a Retry-After header, it is used instead of the backoff.
+// A Retry-After longer than MaxWait (or 10 seconds if MaxWait is not set) is not retried.
+type RetryPolicy struct {
+	Backoff            func(attempt int) time.Duration // Backoff overrides the exponential backoff (optional)
+	Jitter             bool                            // Jitter will randomize the backoff (full jitter)
+	MaxRetries         int                             // MaxRetries is the max number of retries (after the first attempt)
+	MaxWait            time.Duration                   // MaxWait is the max wait between retries
+	RetryNonIdempotent bool                            // RetryNonIdempotent also retries POST on server/transport errors
+	Wait               time.Duration                   // Wait is the initial wait between retries (doubles each attempt)
+}
+
+// DefaultRetryPolicy will return a policy with 2 retries, starting at 500ms up to 10 seconds (with jitter)
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		Jitter:     true,
+		MaxRetries: defaultRetryPolicyMaxRetries,
+		MaxWait:    defaultRetryPolicyMaxWait,
+		Wait:       defaultRetryPolicyWait,
+	}
+}
+
+// retryable will return true if the request can be safely retried
+func (r *RetryPolicy) retryable(ctx context.Context, httpMethod string, resp *resty.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err == nil && resp.StatusCode() == http.StatusTooManyRequests {
+		return true
+	}
+	if err == nil && resp.StatusCode() < http.StatusInternalServerError {
+		return false
+	}
+	return r.RetryNonIdempotent || idempotentMethod(httpMethod)
+}
+
+// wait will return the wait before the next attempt (Retry-After if set, otherwise the backoff)
+// Returns false if the Retry-After is longer than the max wait (the request should not be retried)
+func (r *RetryPolicy) wait(attempt int, resp *resty.Response) (time.Duration, bool) {
+	if resp != nil {
+		if d, ok := retryAfter(resp.Header().Get("Retry-After"), time.Now()); ok {
+			maxWait := r.MaxWait
+			if maxWait <= 0 {
+				maxWait = defaultRetryPolicyMaxWait
+			}
+			return d, d <= maxWait
+		}
+	}
+	if r.Backoff != nil {
+		return r.Backoff(attempt), true
+	}
+	if r.Jitter {
+		return backoff(r.Wait, r.MaxWait, attempt-1), true
+	}
+	return exponentialWait(r.Wait, r.MaxWait, attempt-1), true
+}
+
+// idempotentMethod will return true if repeating the request has the same effect
+func idempotentMethod(httpMethod string) bool {
+	switch httpMethod {
+	case http.MethodGet, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryAfter will parse the Retry-After header (seconds or an HTTP date)
+func retryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if d := date.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -0,0 +1,305 @@
+package customerio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// testRetryPolicy is a fast policy for testing
+var testRetryPolicy = &RetryPolicy{
+	MaxRetries: 2,
+	MaxWait:    5 * time.Millisecond,
+	Wait:       time.Millisecond,
+}
+
+// newTestRetryClient will return a test client using the retry policy
+func newTestRetryClient(policy *RetryPolicy) (*Client, error) {
+	client, err := newTestClient()
+	if err != nil {
+		return nil, err
+	}
+	client.options.retryPolicy = policy
+	return client, nil
+}
+
+// mockRetry will return the status codes in order (one per request)
+func mockRetry(httpMethod string, header http.Header, statusCodes ...int) {
+	httpmock.Reset()
+	responses := make([]*http.Response, 0, len(statusCodes))
+	for _, statusCode := range statusCodes {
+		resp := httpmock.NewStringResponse(statusCode, `{}`)
+		for key, values := range header {
+			resp.Header[key] = values
+		}
+		responses = append(responses, resp)
+	}
+	httpmock.RegisterResponder(httpMethod, testTrackingAPIURL+"retry",
+		httpmock.ResponderFromMultipleResponses(responses),
+	)
+}
+
+// TestWithRetryPolicy will test the method WithRetryPolicy()
+func TestWithRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default is no policy", func(t *testing.T) {
+		client, err := NewClient(WithTrackingKey(testSiteID, testTrackingAPIKey))
+		assert.NoError(t, err)
+		assert.Nil(t, client.options.retryPolicy)
+	})
+
+	t.Run("default policy", func(t *testing.T) {
+		client, err := NewClient(WithTrackingKey(testSiteID, testTrackingAPIKey), WithRetryPolicy(DefaultRetryPolicy()))
+		assert.NoError(t, err)
+		assert.NotNil(t, client.options.retryPolicy)
+		assert.Equal(t, defaultRetryPolicyMaxRetries, client.options.retryPolicy.MaxRetries)
+		assert.Equal(t, defaultRetryPolicyWait, client.options.retryPolicy.Wait)
+		assert.Equal(t, defaultRetryPolicyMaxWait, client.options.retryPolicy.MaxWait)
+		assert.True(t, client.options.retryPolicy.Jitter)
+		assert.Equal(t, 0, client.httpClient.RetryCount)
+	})
+}
+
+// TestClient_requestRetry will test the method request() using a RetryPolicy
+func TestClient_requestRetry(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("rate limit is retried (POST)", func(t *testing.T) {
+		client, err := newTestRetryClient(testRetryPolicy)
+		assert.NoError(t, err)
+
+		mockRetry(http.MethodPost, nil, http.StatusTooManyRequests, http.StatusOK)
+
+		var resp StandardResponse
+		resp, err = client.request(context.Background(), "Test", http.MethodPost, testTrackingAPIURL+"retry", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 2, resp.Attempts)
+	})
+
+	t.Run("server error is retried (GET)", func(t *testing.T) {
+		client, err := newTestRetryClient(testRetryPolicy)
+		assert.NoError(t, err)
+
+		mockRetry(http.MethodGet, nil, http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK)
+
+		var resp StandardResponse
+		resp, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"retry", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 3, resp.Attempts)
+	})
+
+	t.Run("server error is not retried (POST)", func(t *testing.T) {
+		client, err := newTestRetryClient(testRetryPolicy)
+		assert.NoError(t, err)
+
+		mockRetry(http.MethodPost, nil, http.StatusServiceUnavailable, http.StatusOK)
+
+		var resp StandardResponse
+		resp, err = client.request(context.Background(), "Test", http.MethodPost, testTrackingAPIURL+"retry", nil)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, 1, resp.Attempts)
+	})
+
+	t.Run("server error is retried (POST) if non-idempotent is allowed", func(t *testing.T) {
+		policy := *testRetryPolicy
+		policy.RetryNonIdempotent = true
+		client, err := newTestRetryClient(&policy)
+		assert.NoError(t, err)
+
+		mockRetry(http.MethodPost, nil, http.StatusServiceUnavailable, http.StatusOK)
+
+		var resp StandardResponse
+		resp, err = client.request(context.Background(), "Test", http.MethodPost, testTrackingAPIURL+"retry", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, resp.Attempts)
+	})
+
+	t.Run("client error is not retried", func(t *testing.T) {
+		client, err := newTestRetryClient(testRetryPolicy)
+		assert.NoError(t, err)
+
+		mockRetry(http.MethodGet, nil, http.StatusBadRequest, http.StatusOK)
+
+		var resp StandardResponse
+		resp, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"retry", nil)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, 1, resp.Attempts)
+	})
+
+	t.Run("max retries", func(t *testing.T) {
+		client, err := newTestRetryClient(testRetryPolicy)
+		assert.NoError(t, err)
+
+		mockRetry(http.MethodGet, nil, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests)
+
+		var resp StandardResponse
+		resp, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"retry", nil)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, testRetryPolicy.MaxRetries+1, resp.Attempts)
+	})
+
+	t.Run("no policy", func(t *testing.T) {
+		client, err := newTestRetryClient(nil)
+		assert.NoError(t, err)
+
+		mockRetry(http.MethodGet, nil, http.StatusTooManyRequests, http.StatusOK)
+
+		var resp StandardResponse
+		resp, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"retry", nil)
+		assert.Error(t, err)
+		assert.Equal(t, 1, resp.Attempts)
+	})
+
+	t.Run("retry after is honored", func(t *testing.T) {
+		policy := *testRetryPolicy
+		policy.MaxRetries = 1
+		policy.MaxWait = 2 * time.Second
+		client, err := newTestRetryClient(&policy)
+		assert.NoError(t, err)
+
+		mockRetry(http.MethodGet, http.Header{"Retry-After": []string{"1"}}, http.StatusTooManyRequests, http.StatusOK)
+
+		start := time.Now()
+		var resp StandardResponse
+		resp, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"retry", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, resp.Attempts)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+
+	t.Run("retry after longer than the max wait is not retried", func(t *testing.T) {
+		client, err := newTestRetryClient(testRetryPolicy)
+		assert.NoError(t, err)
+
+		mockRetry(http.MethodGet, http.Header{"Retry-After": []string{"86400"}}, http.StatusTooManyRequests, http.StatusOK)
+
+		start := time.Now()
+		var resp StandardResponse
+		resp, err = client.request(context.Background(), "Test", http.MethodGet, testTrackingAPIURL+"retry", nil)
+		assert.True(t, errors.Is(err, ErrRateLimited))
+		assert.Equal(t, 1, resp.Attempts)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("context canceled while waiting", func(t *testing.T) {
+		policy := *testRetryPolicy
+		policy.MaxWait = time.Minute
+		client, err := newTestRetryClient(&policy)
+		assert.NoError(t, err)
+
+		mockRetry(http.MethodGet, http.Header{"Retry-After": []string{"60"}}, http.StatusTooManyRequests)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		var resp StandardResponse
+		resp, err = client.request(ctx, "Test", http.MethodGet, testTrackingAPIURL+"retry", nil)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.Equal(t, 1, resp.Attempts)
+	})
+}
+
+// TestRetryPolicy_wait will test the method wait()
+func TestRetryPolicy_wait(t *testing.T) {
+	t.Parallel()
+
+	// wait will return the wait (the backoff is always retried)
+	wait := func(policy *RetryPolicy, attempt int) time.Duration {
+		d, ok := policy.wait(attempt, nil)
+		assert.True(t, ok)
+		return d
+	}
+
+	t.Run("exponential backoff", func(t *testing.T) {
+		policy := &RetryPolicy{Wait: 100 * time.Millisecond, MaxWait: time.Second}
+		assert.Equal(t, 100*time.Millisecond, wait(policy, 1))
+		assert.Equal(t, 200*time.Millisecond, wait(policy, 2))
+		assert.Equal(t, 400*time.Millisecond, wait(policy, 3))
+		assert.Equal(t, time.Second, wait(policy, 5))
+		assert.Equal(t, time.Second, wait(policy, 100))
+	})
+
+	t.Run("jitter", func(t *testing.T) {
+		policy := &RetryPolicy{Jitter: true, Wait: 100 * time.Millisecond, MaxWait: time.Second}
+		for attempt := 1; attempt < 10; attempt++ {
+			assert.LessOrEqual(t, wait(policy, attempt), time.Second)
+		}
+	})
+
+	t.Run("custom backoff", func(t *testing.T) {
+		policy := &RetryPolicy{Backoff: func(attempt int) time.Duration {
+			return time.Duration(attempt) * time.Minute
+		}}
+		assert.Equal(t, 3*time.Minute, wait(policy, 3))
+	})
+
+	t.Run("retry after", func(t *testing.T) {
+		resp := func(retryAfter string) *resty.Response {
+			return &resty.Response{RawResponse: &http.Response{Header: http.Header{"Retry-After": []string{retryAfter}}}}
+		}
+
+		policy := &RetryPolicy{Wait: time.Millisecond, MaxWait: time.Minute}
+		d, ok := policy.wait(1, resp("30"))
+		assert.True(t, ok)
+		assert.Equal(t, 30*time.Second, d)
+
+		_, ok = policy.wait(1, resp("86400"))
+		assert.False(t, ok)
+
+		// No max wait uses the default max wait
+		policy = &RetryPolicy{Wait: time.Millisecond}
+		_, ok = policy.wait(1, resp("60"))
+		assert.False(t, ok)
+		d, ok = policy.wait(1, resp("5"))
+		assert.True(t, ok)
+		assert.Equal(t, 5*time.Second, d)
+	})
+}
+
+// TestRetryAfter will test the method retryAfter()
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	var tests = []struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{"", 0, false},
+		{"invalid", 0, false},
+		{"-1", 0, false},
+		{"0", 0, true},
+		{"5", 5 * time.Second, true},
+		{now.Add(30 * time.Second).Format(http.TimeFormat), 30 * time.Second, true},
+		{now.Add(-30 * time.Second).Format(http.TimeFormat), 0, true},
+	}
+	for _, test := range tests {
+		d, ok := retryAfter(test.value, now)
+		assert.Equal(t, test.ok, ok, test.value)
+		assert.Equal(t, test.expected, d, test.value)
+	}
+}
+
+// TestIdempotentMethod will test the method idempotentMethod()
+func TestIdempotentMethod(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, idempotentMethod(http.MethodGet))
+	assert.True(t, idempotentMethod(http.MethodPut))
+	assert.True(t, idempotentMethod(http.MethodDelete))
+	assert.False(t, idempotentMethod(http.MethodPost))
+}
@@ -0,0 +1,296 @@
+package customerio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Segment is a group of customers (data-driven or manual)
+// See: https://customer.io/docs/api/app/#tag/Segments
+type Segment struct {
+	DeduplicateID string   `json:"deduplicate_id"`
+	Description   string   `json:"description"`
+	ID            int64    `json:"id"`
+	Name          string   `json:"name"`
+	Progress      *int     `json:"progress"` // Progress is the percentage complete while the segment is building
+	State         string   `json:"state"`
+	Tags          []string `json:"tags"`
+	Type          string   `json:"type"` // Type is either "dynamic" or "manual"
+}
+
+// SegmentRequest is the request to create a manual segment
+// See: https://customer.io/docs/api/app/#operation/createManSegment
+type SegmentRequest struct {
+	Description string   `json:"description,omitempty"`
+	Name        string   `json:"name"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// SegmentDependencies are the campaigns and newsletters that use a segment
+type SegmentDependencies struct {
+	Campaigns        []int64 `json:"campaigns"`
+	DraftNewsletters []int64 `json:"draft_newsletters"`
+	SentNewsletters  []int64 `json:"sent_newsletters"`
+}
+
+// CreateSegment will create a manual segment
+// See: https://customer.io/docs/api/app/#operation/createManSegment
+func (c *Client) CreateSegment(segment *SegmentRequest) (*Segment, error) {
+	return c.CreateSegmentWithContext(context.Background(), segment)
+}
+
+// CreateSegmentWithContext is the same as CreateSegment() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/createManSegment
+func (c *Client) CreateSegmentWithContext(ctx context.Context, segment *SegmentRequest) (*Segment, error) {
+	if segment == nil {
+		return nil, ParamError{Param: "segment"}
+	} else if segment.Name == "" {
+		return nil, ParamError{Param: "name"}
+	}
+	response, err := c.request(
+		ctx,
+		"CreateSegment",
+		http.MethodPost,
+		fmt.Sprintf("%s/v1/segments", c.options.apiURL),
+		map[string]interface{}{
+			"segment": segment,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	var r struct {
+		Segment *Segment `json:"segment"`
+	}
+	if err = json.Unmarshal(response.Body, &r); err != nil {
+		return nil, err
+	}
+	return r.Segment, nil
+}
+
+// ListSegments will return all the segments in the workspace
+// See: https://customer.io/docs/api/app/#operation/listSegments
+func (c *Client) ListSegments() ([]*Segment, error) {
+	return c.ListSegmentsWithContext(context.Background())
+}
+
+// ListSegmentsWithContext is the same as ListSegments() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/listSegments
+func (c *Client) ListSegmentsWithContext(ctx context.Context) ([]*Segment, error) {
+	var r struct {
+		Segments []*Segment `json:"segments"`
+	}
+	if err := c.getSegmentResource(ctx, "ListSegments", 0, "", nil, &r); err != nil {
+		return nil, err
+	}
+	return r.Segments, nil
+}
+
+// GetSegment will return a segment
+// See: https://customer.io/docs/api/app/#operation/getSegment
+func (c *Client) GetSegment(segmentID int64) (*Segment, error) {
+	return c.GetSegmentWithContext(context.Background(), segmentID)
+}
+
+// GetSegmentWithContext is the same as GetSegment() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getSegment
+func (c *Client) GetSegmentWithContext(ctx context.Context, segmentID int64) (*Segment, error) {
+	if segmentID <= 0 {
+		return nil, ParamError{Param: "segmentID"}
+	}
+	var r struct {
+		Segment *Segment `json:"segment"`
+	}
+	if err := c.getSegmentResource(ctx, "GetSegment", segmentID, "", nil, &r); err != nil {
+		return nil, err
+	}
+	return r.Segment, nil
+}
+
+// DeleteSegment will delete a segment
+// See: https://customer.io/docs/api/app/#operation/deleteManSegment
+func (c *Client) DeleteSegment(segmentID int64) error {
+	return c.DeleteSegmentWithContext(context.Background(), segmentID)
+}
+
+// DeleteSegmentWithContext is the same as DeleteSegment() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/deleteManSegment
+func (c *Client) DeleteSegmentWithContext(ctx context.Context, segmentID int64) error {
+	if segmentID <= 0 {
+		return ParamError{Param: "segmentID"}
+	}
+	_, err := c.request(
+		ctx,
+		"DeleteSegment",
+		http.MethodDelete,
+		fmt.Sprintf("%s/v1/segments/%d", c.options.apiURL, segmentID),
+		nil,
+	)
+	return err
+}
+
+// GetSegmentDependencies will return the campaigns and newsletters that use the segment
+// See: https://customer.io/docs/api/app/#operation/getSegmentDependencies
+func (c *Client) GetSegmentDependencies(segmentID int64) (*SegmentDependencies, error) {
+	return c.GetSegmentDependenciesWithContext(context.Background(), segmentID)
+}
+
+// GetSegmentDependenciesWithContext is the same as GetSegmentDependencies() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getSegmentDependencies
+func (c *Client) GetSegmentDependenciesWithContext(ctx context.Context,
+	segmentID int64) (*SegmentDependencies, error) {
+	if segmentID <= 0 {
+		return nil, ParamError{Param: "segmentID"}
+	}
+	var r struct {
+		UsedBy *SegmentDependencies `json:"used_by"`
+	}
+	if err := c.getSegmentResource(ctx, "GetSegmentDependencies", segmentID, "used_by", nil, &r); err != nil {
+		return nil, err
+	}
+	return r.UsedBy, nil
+}
+
+// GetSegmentCustomerCount will return the number of customers in the segment
+// See: https://customer.io/docs/api/app/#operation/getSegmentCount
+func (c *Client) GetSegmentCustomerCount(segmentID int64) (int64, error) {
+	return c.GetSegmentCustomerCountWithContext(context.Background(), segmentID)
+}
+
+// GetSegmentCustomerCountWithContext is the same as GetSegmentCustomerCount() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getSegmentCount
+func (c *Client) GetSegmentCustomerCountWithContext(ctx context.Context, segmentID int64) (int64, error) {
+	if segmentID <= 0 {
+		return 0, ParamError{Param: "segmentID"}
+	}
+	var r struct {
+		Count int64 `json:"count"`
+	}
+	if err := c.getSegmentResource(ctx, "GetSegmentCustomerCount", segmentID, "customer_count", nil, &r); err != nil {
+		return 0, err
+	}
+	return r.Count, nil
+}
+
+// GetSegmentMembership will return a page of the customers in the segment and the cursor for the next page
+// See: https://customer.io/docs/api/app/#operation/getSegmentMembership
+func (c *Client) GetSegmentMembership(segmentID int64, opts *ListOptions) ([]*CustomerIdentifiers, string, error) {
+	return c.GetSegmentMembershipWithContext(context.Background(), segmentID, opts)
+}
+
+// GetSegmentMembershipWithContext is the same as GetSegmentMembership() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getSegmentMembership
+func (c *Client) GetSegmentMembershipWithContext(ctx context.Context, segmentID int64,
+	opts *ListOptions) ([]*CustomerIdentifiers, string, error) {
+	if segmentID <= 0 {
+		return nil, "", ParamError{Param: "segmentID"}
+	}
+	var r struct {
+		Identifiers []*CustomerIdentifiers `json:"identifiers"`
+		Next        string                 `json:"next"`
+	}
+	if err := c.getSegmentResource(ctx, "GetSegmentMembership", segmentID, "membership", opts.values(), &r); err != nil {
+		return nil, "", err
+	}
+	return r.Identifiers, r.Next, nil
+}
+
+// SegmentMembershipPaginator will return a paginator over all the customers in the segment
+// See: https://customer.io/docs/api/app/#operation/getSegmentMembership
+func (c *Client) SegmentMembershipPaginator(segmentID int64,
+	opts ...PaginatorOps) *Paginator[*CustomerIdentifiers] {
+	return NewPaginator(func(ctx context.Context, list *ListOptions) ([]*CustomerIdentifiers, string, error) {
+		return c.GetSegmentMembershipWithContext(ctx, segmentID, list)
+	}, opts...)
+}
+
+// getSegmentResource will fire a GET request for the segments (or a segment's resource)
+// and unmarshal the response into v
+func (c *Client) getSegmentResource(ctx context.Context, operation string, segmentID int64, resource string,
+	values url.Values, v interface{}) error {
+	path := "/v1/segments"
+	if segmentID > 0 {
+		path += fmt.Sprintf("/%d", segmentID)
+	}
+	if resource != "" {
+		path += "/" + resource
+	}
+	return c.getAppResource(ctx, operation, path, values, v)
+}
+
+// AddToSegment will add customers to a manual segment
+// The ids are sent in chunks of 1,000 (the max per request)
+// The idType is the type of the ids (id, email or cio_id), defaults to id
+// See: https://customer.io/docs/api/track/#operation/add_to_segment
+func (c *Client) AddToSegment(segmentID int64, ids []string, idType IDType) error {
+	return c.AddToSegmentWithContext(context.Background(), segmentID, ids, idType)
+}
+
+// AddToSegmentWithContext is the same as AddToSegment() but uses the given context
+// See: https://customer.io/docs/api/track/#operation/add_to_segment
+func (c *Client) AddToSegmentWithContext(ctx context.Context, segmentID int64, ids []string, idType IDType) error {
+	return c.updateSegmentCustomers(ctx, "AddToSegment", segmentID, "add_customers", ids, idType)
+}
+
+// RemoveFromSegment will remove customers from a manual segment
+// The ids are sent in chunks of 1,000 (the max per request)
+// The idType is the type of the ids (id, email or cio_id), defaults to id
+// See: https://customer.io/docs/api/track/#operation/remove_from_segment
+func (c *Client) RemoveFromSegment(segmentID int64, ids []string, idType IDType) error {
+	return c.RemoveFromSegmentWithContext(context.Background(), segmentID, ids, idType)
+}
+
+// RemoveFromSegmentWithContext is the same as RemoveFromSegment() but uses the given context
+// See: https://customer.io/docs/api/track/#operation/remove_from_segment
+func (c *Client) RemoveFromSegmentWithContext(ctx context.Context, segmentID int64, ids []string,
+	idType IDType) error {
+	return c.updateSegmentCustomers(ctx, "RemoveFromSegment", segmentID, "remove_customers", ids, idType)
+}
+
+// updateSegmentCustomers will add/remove the customers of a manual segment (in chunks)
+// If a chunk fails, the remaining chunks are not sent
+func (c *Client) updateSegmentCustomers(ctx context.Context, operation string, segmentID int64, action string,
+	ids []string, idType IDType) error {
+	if segmentID <= 0 {
+		return ParamError{Param: "segmentID"}
+	} else if len(ids) == 0 {
+		return ParamError{Param: "ids"}
+	} else if !acceptedIDTypes(idType) {
+		return ParamError{Param: "idType"}
+	}
+	for _, id := range ids {
+		if id == "" {
+			return ParamError{Param: "ids"}
+		}
+	}
+
+	values := url.Values{}
+	if idType != "" {
+		values.Set("id_type", string(idType))
+	}
+	requestURL := withQuery(
+		fmt.Sprintf("%s/api/v1/segments/%d/%s", c.options.trackURL, segmentID, action), values,
+	)
+
+	for start := 0; start < len(ids); start += maxSegmentCustomers {
+		end := start + maxSegmentCustomers
+		if end > len(ids) {
+			end = len(ids)
+		}
+		if _, err := c.request(
+			ctx,
+			operation,
+			http.MethodPost,
+			requestURL,
+			map[string]interface{}{
+				"ids": ids[start:end],
+			},
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,491 @@
+package customerio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// testSegmentID is the segment id used in tests
+const testSegmentID int64 = 7
+
+// TestClient_AddToSegment will test the method AddToSegment()
+func TestClient_AddToSegment(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockUpdateSegmentCustomers(http.StatusOK, "add_customers", "")
+
+		err = client.AddToSegment(testSegmentID, []string{testCustomerID}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	})
+
+	t.Run("using email", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockUpdateSegmentCustomers(http.StatusOK, "add_customers", IDTypeEmail)
+
+		err = client.AddToSegment(testSegmentID, []string{testCustomerEmail}, IDTypeEmail)
+		assert.NoError(t, err)
+	})
+
+	t.Run("chunked requests", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockUpdateSegmentCustomers(http.StatusOK, "add_customers", "")
+
+		ids := make([]string, maxSegmentCustomers*2+1)
+		for i := range ids {
+			ids[i] = fmt.Sprintf("%d", i)
+		}
+		err = client.AddToSegment(testSegmentID, ids, "")
+		assert.NoError(t, err)
+		assert.Equal(t, 3, httpmock.GetTotalCallCount())
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		checkParamError(t, client.AddToSegment(0, []string{testCustomerID}, ""), "segmentID")
+		checkParamError(t, client.AddToSegment(testSegmentID, nil, ""), "ids")
+		checkParamError(t, client.AddToSegment(testSegmentID, []string{""}, ""), "ids")
+		checkParamError(t, client.AddToSegment(testSegmentID, []string{testCustomerID}, "phone"), "idType")
+	})
+
+	t.Run("customerIo error stops sending", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockUpdateSegmentCustomers(http.StatusUnauthorized, "add_customers", "")
+
+		ids := make([]string, maxSegmentCustomers+1)
+		for i := range ids {
+			ids[i] = fmt.Sprintf("%d", i)
+		}
+		err = client.AddToSegment(testSegmentID, ids, "")
+		assert.Error(t, err)
+		assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	})
+}
+
+// TestClient_RemoveFromSegment will test the method RemoveFromSegment()
+func TestClient_RemoveFromSegment(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockUpdateSegmentCustomers(http.StatusOK, "remove_customers", IDTypeCioID)
+
+		err = client.RemoveFromSegment(testSegmentID, []string{"a1b2c3"}, IDTypeCioID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing ids", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		checkParamError(t, client.RemoveFromSegment(testSegmentID, []string{}, ""), "ids")
+	})
+}
+
+// TestClient_CreateSegment will test the method CreateSegment()
+func TestClient_CreateSegment(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSegments(http.StatusOK, http.MethodPost, "", "", testSegmentResponse)
+
+		var segment *Segment
+		segment, err = client.CreateSegment(&SegmentRequest{Name: "Billing: past due", Tags: []string{"billing"}})
+		assert.NoError(t, err)
+		assert.NotNil(t, segment)
+		assert.Equal(t, testSegmentID, segment.ID)
+		assert.Equal(t, "manual", segment.Type)
+	})
+
+	t.Run("missing segment", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.CreateSegment(nil)
+		checkParamError(t, err, "segment")
+
+		_, err = client.CreateSegment(&SegmentRequest{})
+		checkParamError(t, err, "name")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSegments(http.StatusUnauthorized, http.MethodPost, "", "", "")
+
+		_, err = client.CreateSegment(&SegmentRequest{Name: "Billing: past due"})
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_ListSegments will test the method ListSegments()
+func TestClient_ListSegments(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSegments(http.StatusOK, http.MethodGet, "", "",
+			`{"segments":[{"id":7,"name":"Billing: past due","type":"manual"},{"id":8,"name":"Active","type":"dynamic"}]}`,
+		)
+
+		var segments []*Segment
+		segments, err = client.ListSegments()
+		assert.NoError(t, err)
+		assert.Len(t, segments, 2)
+		assert.Equal(t, "dynamic", segments[1].Type)
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSegments(http.StatusUnauthorized, http.MethodGet, "", "", "")
+
+		_, err = client.ListSegments()
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_GetSegment will test the method GetSegment()
+func TestClient_GetSegment(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSegments(http.StatusOK, http.MethodGet, fmt.Sprintf("/%d", testSegmentID), "", testSegmentResponse)
+
+		var segment *Segment
+		segment, err = client.GetSegment(testSegmentID)
+		assert.NoError(t, err)
+		assert.NotNil(t, segment)
+		assert.Equal(t, "Billing: past due", segment.Name)
+		assert.Equal(t, []string{"billing"}, segment.Tags)
+	})
+
+	t.Run("missing segment id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetSegment(0)
+		checkParamError(t, err, "segmentID")
+	})
+
+	t.Run("bad json", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSegments(http.StatusOK, http.MethodGet, fmt.Sprintf("/%d", testSegmentID), "", `{"segment":`)
+
+		_, err = client.GetSegment(testSegmentID)
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_DeleteSegment will test the method DeleteSegment()
+func TestClient_DeleteSegment(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSegments(http.StatusOK, http.MethodDelete, fmt.Sprintf("/%d", testSegmentID), "", "")
+
+		err = client.DeleteSegment(testSegmentID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing segment id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		checkParamError(t, client.DeleteSegment(0), "segmentID")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSegments(http.StatusNotFound, http.MethodDelete, fmt.Sprintf("/%d", testSegmentID), "", "")
+
+		assert.Error(t, client.DeleteSegment(testSegmentID))
+	})
+}
+
+// TestClient_GetSegmentDependencies will test the method GetSegmentDependencies()
+func TestClient_GetSegmentDependencies(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSegments(http.StatusOK, http.MethodGet, fmt.Sprintf("/%d/used_by", testSegmentID), "",
+			`{"used_by":{"campaigns":[1,2],"sent_newsletters":[3],"draft_newsletters":[]}}`,
+		)
+
+		var dependencies *SegmentDependencies
+		dependencies, err = client.GetSegmentDependencies(testSegmentID)
+		assert.NoError(t, err)
+		assert.NotNil(t, dependencies)
+		assert.Equal(t, []int64{1, 2}, dependencies.Campaigns)
+		assert.Equal(t, []int64{3}, dependencies.SentNewsletters)
+		assert.Empty(t, dependencies.DraftNewsletters)
+	})
+
+	t.Run("missing segment id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetSegmentDependencies(0)
+		checkParamError(t, err, "segmentID")
+	})
+}
+
+// TestClient_GetSegmentCustomerCount will test the method GetSegmentCustomerCount()
+func TestClient_GetSegmentCustomerCount(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSegments(http.StatusOK, http.MethodGet, fmt.Sprintf("/%d/customer_count", testSegmentID), "",
+			`{"count":42}`,
+		)
+
+		var count int64
+		count, err = client.GetSegmentCustomerCount(testSegmentID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), count)
+	})
+
+	t.Run("missing segment id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, err = client.GetSegmentCustomerCount(-1)
+		checkParamError(t, err, "segmentID")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSegments(http.StatusUnauthorized, http.MethodGet, fmt.Sprintf("/%d/customer_count", testSegmentID), "", "")
+
+		_, err = client.GetSegmentCustomerCount(testSegmentID)
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_GetSegmentMembership will test the method GetSegmentMembership()
+func TestClient_GetSegmentMembership(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSegments(http.StatusOK, http.MethodGet, fmt.Sprintf("/%d/membership", testSegmentID), "limit=2",
+			`{"identifiers":[{"id":"123","email":"bob@example.com"},{"id":"456"}],"ids":["123","456"],"next":"abc"}`,
+		)
+
+		var identifiers []*CustomerIdentifiers
+		var next string
+		identifiers, next, err = client.GetSegmentMembership(testSegmentID, &ListOptions{Limit: 2})
+		assert.NoError(t, err)
+		assert.Len(t, identifiers, 2)
+		assert.Equal(t, testCustomerEmail, identifiers[0].Email)
+		assert.Equal(t, "abc", next)
+	})
+
+	t.Run("missing segment id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, _, err = client.GetSegmentMembership(0, nil)
+		checkParamError(t, err, "segmentID")
+	})
+}
+
+// TestClient_SegmentMembershipPaginator will test the method SegmentMembershipPaginator()
+func TestClient_SegmentMembershipPaginator(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	client, err := newTestClient()
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+
+	mockSegments(http.StatusOK, http.MethodGet, fmt.Sprintf("/%d/membership", testSegmentID), "",
+		`{"identifiers":[{"id":"123"},{"id":"456"}],"next":"abc"}`,
+	)
+	httpmock.RegisterResponder(http.MethodGet,
+		fmt.Sprintf("%sv1/segments/%d/membership?start=abc", testAppAPIURL, testSegmentID),
+		httpmock.NewStringResponder(http.StatusOK, `{"identifiers":[{"id":"789"}],"next":""}`),
+	)
+
+	var identifiers []*CustomerIdentifiers
+	identifiers, err = client.SegmentMembershipPaginator(testSegmentID).All(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, identifiers, 3)
+	assert.Equal(t, "789", identifiers[2].ID)
+}
+
+// ExampleClient_AddToSegment example using AddToSegment()
+//
+// See more examples in /examples/
+func ExampleClient_AddToSegment() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockUpdateSegmentCustomers(http.StatusOK, "add_customers", IDTypeID)
+
+	// Add the customers to the manual segment
+	if err = client.AddToSegment(testSegmentID, []string{testCustomerID}, IDTypeID); err != nil {
+		fmt.Printf("error adding to segment: %s", err.Error())
+		return
+	}
+	fmt.Printf("added to segment: %d", testSegmentID)
+	// Output:added to segment: 7
+}
+
+// BenchmarkClient_AddToSegment benchmarks the method AddToSegment()
+func BenchmarkClient_AddToSegment(b *testing.B) {
+	client, _ := newTestClient()
+	mockUpdateSegmentCustomers(http.StatusOK, "add_customers", "")
+	for i := 0; i < b.N; i++ {
+		_ = client.AddToSegment(testSegmentID, []string{testCustomerID}, "")
+	}
+}
+
+// ExampleClient_GetSegmentCustomerCount example using GetSegmentCustomerCount()
+//
+// See more examples in /examples/
+func ExampleClient_GetSegmentCustomerCount() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockSegments(http.StatusOK, http.MethodGet, fmt.Sprintf("/%d/customer_count", testSegmentID), "",
+		`{"count":42}`,
+	)
+
+	// Get the number of customers in the segment
+	var count int64
+	if count, err = client.GetSegmentCustomerCount(testSegmentID); err != nil {
+		fmt.Printf("error getting segment count: %s", err.Error())
+		return
+	}
+	fmt.Printf("customers in segment: %d", count)
+	// Output:customers in segment: 42
+}
+
+// BenchmarkClient_GetSegment benchmarks the method GetSegment()
+func BenchmarkClient_GetSegment(b *testing.B) {
+	client, _ := newTestClient()
+	mockSegments(http.StatusOK, http.MethodGet, fmt.Sprintf("/%d", testSegmentID), "", testSegmentResponse)
+	for i := 0; i < b.N; i++ {
+		_, _ = client.GetSegment(testSegmentID)
+	}
+}
+
+// testSegmentResponse is the response for a single segment
+const testSegmentResponse = `{"segment":{"id":7,"deduplicate_id":"7:1600000000","name":"Billing: past due",` +
+	`"description":"","state":"finished","progress":null,"type":"manual","tags":["billing"]}}`
+
+// mockSegments is used for mocking the response
+func mockSegments(statusCode int, method, path, query, body string) {
+	httpmock.Reset()
+	requestURL := fmt.Sprintf("%sv1/segments%s", testAppAPIURL, path)
+	if query != "" {
+		requestURL += "?" + query
+	}
+	httpmock.RegisterResponder(method, requestURL,
+		httpmock.NewStringResponder(
+			statusCode, body,
+		),
+	)
+}
+
+// mockUpdateSegmentCustomers is used for mocking the response
+// Responds with a 400 if the id_type does not match or a request has too many ids
+func mockUpdateSegmentCustomers(statusCode int, action string, idType IDType) {
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost,
+		fmt.Sprintf("%sapi/v1/segments/%d/%s", testTrackingAPIURL, testSegmentID, action),
+		func(req *http.Request) (*http.Response, error) {
+			var body struct {
+				IDs []string `json:"ids"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil ||
+				len(body.IDs) == 0 || len(body.IDs) > maxSegmentCustomers ||
+				req.URL.Query().Get("id_type") != string(idType) {
+				return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+			}
+			return httpmock.NewStringResponse(statusCode, ""), nil
+		},
+	)
+}
@@ -0,0 +1,65 @@
+package customerio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SMSRequest is the request structure for sending an SMS
+type SMSRequest struct {
+	Body                    string                 `json:"body,omitempty"` // Overrides the template body
+	DisableMessageRetention *bool                  `json:"disable_message_retention,omitempty"`
+	EnableTracking          *bool                  `json:"tracked,omitempty"`
+	From                    string                 `json:"from,omitempty"` // Overrides the template sender
+	Identifiers             map[string]string      `json:"identifiers"`
+	MessageData             map[string]interface{} `json:"message_data,omitempty"`
+	QueueDraft              *bool                  `json:"queue_draft,omitempty"`
+	SendToUnsubscribed      *bool                  `json:"send_to_unsubscribed,omitempty"`
+	To                      string                 `json:"to,omitempty"` // Phone number (defaults to the customer's phone)
+	TransactionalMessageID  string                 `json:"transactional_message_id"`
+}
+
+// SendSMS sends a single transactional SMS using the Customer.io transactional API
+// See: https://customer.io/docs/api/app/#operation/sendSMS
+func (c *Client) SendSMS(smsRequest *SMSRequest) (*TransactionalResponse, error) {
+	return c.SendSMSWithContext(context.Background(), smsRequest)
+}
+
+// SendSMSWithContext is the same as SendSMS() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/sendSMS
+func (c *Client) SendSMSWithContext(ctx context.Context, smsRequest *SMSRequest) (*TransactionalResponse, error) {
+
+	// Request cannot be nil, don't panic dude!
+	if smsRequest == nil {
+		return nil, ParamError{Param: "smsRequest"}
+	}
+
+	// A template is always required for SMS
+	if smsRequest.TransactionalMessageID == "" {
+		return nil, ParamError{Param: "smsTransactionalMessageID"}
+	} else if len(smsRequest.Identifiers) == 0 {
+		return nil, ParamError{Param: "smsIdentifiers"}
+	}
+
+	// Attempt to send the SMS
+	response, err := c.request(
+		ctx,
+		"SendSMS",
+		http.MethodPost,
+		fmt.Sprintf("%s/v1/send/sms", c.options.apiURL),
+		smsRequest,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the response
+	var r TransactionalResponse
+	if err = json.Unmarshal(response.Body, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
@@ -0,0 +1,159 @@
+package customerio
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestSMSRequest will return an SMS request for testing
+func newTestSMSRequest() *SMSRequest {
+	return &SMSRequest{
+		Identifiers: map[string]string{"id": testCustomerID},
+		MessageData: map[string]interface{}{
+			"code": "123456",
+		},
+		To:                     "+15555555555",
+		TransactionalMessageID: "4",
+	}
+}
+
+// TestClient_SendSMS will test the method SendSMS()
+func TestClient_SendSMS(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSendSMS(http.StatusOK, `{"delivery_id": "1234567890","queued_at": 1620313799}`)
+
+		var resp *TransactionalResponse
+		resp, err = client.SendSMS(newTestSMSRequest())
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Equal(t, "1234567890", resp.DeliveryID)
+	})
+
+	t.Run("missing sms request", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		var resp *TransactionalResponse
+		resp, err = client.SendSMS(nil)
+		assert.Nil(t, resp)
+		checkParamError(t, err, "smsRequest")
+	})
+
+	t.Run("missing transactional message id", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		req := newTestSMSRequest()
+		req.TransactionalMessageID = ""
+
+		var resp *TransactionalResponse
+		resp, err = client.SendSMS(req)
+		assert.Nil(t, resp)
+		checkParamError(t, err, "smsTransactionalMessageID")
+	})
+
+	t.Run("missing identifiers", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		req := newTestSMSRequest()
+		req.Identifiers = nil
+
+		var resp *TransactionalResponse
+		resp, err = client.SendSMS(req)
+		assert.Nil(t, resp)
+		checkParamError(t, err, "smsIdentifiers")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSendSMS(http.StatusBadRequest, `{"meta":{"error":"invalid phone number"}}`)
+
+		var resp *TransactionalResponse
+		resp, err = client.SendSMS(newTestSMSRequest())
+		assert.Nil(t, resp)
+
+		var tErr *TransactionalError
+		assert.True(t, errors.As(err, &tErr))
+		assert.Equal(t, http.StatusBadRequest, tErr.StatusCode)
+		assert.Equal(t, "invalid phone number", tErr.Error())
+	})
+
+	t.Run("invalid response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockSendSMS(http.StatusOK, `{"delivery_id":`)
+
+		var resp *TransactionalResponse
+		resp, err = client.SendSMS(newTestSMSRequest())
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+	})
+}
+
+// ExampleClient_SendSMS example using SendSMS()
+//
+// See more examples in /examples/
+func ExampleClient_SendSMS() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockSendSMS(http.StatusOK, `{"delivery_id": "1234567890","queued_at": 1620313799}`)
+
+	// Send SMS
+	var resp *TransactionalResponse
+	if resp, err = client.SendSMS(&SMSRequest{
+		Identifiers:            map[string]string{"id": "123"},
+		MessageData:            map[string]interface{}{"code": "123456"},
+		TransactionalMessageID: "4",
+	}); err != nil {
+		fmt.Printf("error sending sms: %s", err.Error())
+		return
+	}
+	fmt.Printf("sms sent: %s", resp.DeliveryID)
+	// Output:sms sent: 1234567890
+}
+
+// BenchmarkClient_SendSMS benchmarks the method SendSMS()
+func BenchmarkClient_SendSMS(b *testing.B) {
+	client, _ := newTestClient()
+	mockSendSMS(http.StatusOK, `{"delivery_id": "1234567890","queued_at": 1620313799}`)
+	req := newTestSMSRequest()
+	for i := 0; i < b.N; i++ {
+		_, _ = client.SendSMS(req)
+	}
+}
+
+// mockSendSMS is used for mocking the response
+func mockSendSMS(statusCode int, body string) {
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("%sv1/send/sms", testAppAPIURL),
+		httpmock.NewStringResponder(
+			statusCode, body,
+		),
+	)
+}
@@ -0,0 +1,109 @@
+package customerio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ESPSuppressionType is the reason an email address is suppressed by the email service provider (ESP)
+type ESPSuppressionType string
+
+// Allowed types of ESP suppressions
+const (
+	ESPSuppressionBounces       ESPSuppressionType = "bounces"
+	ESPSuppressionBlocks        ESPSuppressionType = "blocks"
+	ESPSuppressionInvalidEmails ESPSuppressionType = "invalid_emails"
+	ESPSuppressionSpamReports   ESPSuppressionType = "spam_reports"
+)
+
+// acceptedESPSuppressionTypes will return true if the suppression type is accepted
+func acceptedESPSuppressionTypes(suppressionType ESPSuppressionType) bool {
+	switch suppressionType {
+	case ESPSuppressionBounces, ESPSuppressionBlocks, ESPSuppressionInvalidEmails, ESPSuppressionSpamReports:
+		return true
+	}
+	return false
+}
+
+// ESPSuppression is an email address suppressed by the email service provider (bounce, block, spam report...)
+//
+// This is not a customer suppressed using SuppressCustomer()
+type ESPSuppression struct {
+	Created int64  `json:"created"`
+	Email   string `json:"email"`
+	Reason  string `json:"reason"`
+	Status  string `json:"status"`
+}
+
+// ListESPSuppressions will return a page of email addresses suppressed by the email service provider
+// and the cursor for the next page
+// This endpoint uses offsets, the cursor is the offset of the next page
+// See: https://customer.io/docs/api/app/#operation/getSuppression
+func (c *Client) ListESPSuppressions(suppressionType ESPSuppressionType,
+	opts *ListOptions) ([]*ESPSuppression, string, error) {
+	return c.ListESPSuppressionsWithContext(context.Background(), suppressionType, opts)
+}
+
+// ListESPSuppressionsWithContext is the same as ListESPSuppressions() but uses the given context
+// See: https://customer.io/docs/api/app/#operation/getSuppression
+func (c *Client) ListESPSuppressionsWithContext(ctx context.Context, suppressionType ESPSuppressionType,
+	opts *ListOptions) ([]*ESPSuppression, string, error) {
+	if !acceptedESPSuppressionTypes(suppressionType) {
+		return nil, "", ParamError{Param: "suppressionType"}
+	}
+
+	// Convert the cursor into an offset
+	var offset int
+	values := url.Values{}
+	if opts != nil {
+		if opts.Start != "" {
+			var err error
+			if offset, err = strconv.Atoi(opts.Start); err != nil {
+				return nil, "", ParamError{Param: "start"}
+			}
+			values.Set("offset", opts.Start)
+		}
+		if opts.Limit > 0 {
+			values.Set("limit", strconv.Itoa(opts.Limit))
+		}
+	}
+
+	response, err := c.request(
+		ctx,
+		"ListESPSuppressions",
+		http.MethodGet,
+		withQuery(
+			fmt.Sprintf("%s/v1/esp/suppression/%s", c.options.apiURL, url.PathEscape(string(suppressionType))),
+			values,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	var r struct {
+		Suppressions []*ESPSuppression `json:"suppressions"`
+	}
+	if err = json.Unmarshal(response.Body, &r); err != nil {
+		return nil, "", err
+	}
+
+	// No results (or a partial page) means there are no more pages
+	if len(r.Suppressions) == 0 || (opts != nil && opts.Limit > 0 && len(r.Suppressions) < opts.Limit) {
+		return r.Suppressions, "", nil
+	}
+	return r.Suppressions, strconv.Itoa(offset + len(r.Suppressions)), nil
+}
+
+// ESPSuppressionsPaginator will return a paginator over all the email addresses suppressed by the ESP
+// See: https://customer.io/docs/api/app/#operation/getSuppression
+func (c *Client) ESPSuppressionsPaginator(suppressionType ESPSuppressionType,
+	opts ...PaginatorOps) *Paginator[*ESPSuppression] {
+	return NewPaginator(func(ctx context.Context, list *ListOptions) ([]*ESPSuppression, string, error) {
+		return c.ListESPSuppressionsWithContext(ctx, suppressionType, list)
+	}, opts...)
+}
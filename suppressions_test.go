@@ -0,0 +1,140 @@
+package customerio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestClient_ListESPSuppressions will test the method ListESPSuppressions()
+func TestClient_ListESPSuppressions(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("successful response", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockListESPSuppressions(http.StatusOK, "limit=2&offset=2",
+			`{"suppressions":[{"email":"bob@example.com","created":1620313799,"reason":"550 mailbox unavailable"}]}`)
+
+		var suppressions []*ESPSuppression
+		var next string
+		suppressions, next, err = client.ListESPSuppressions(ESPSuppressionBounces, &ListOptions{Limit: 2, Start: "2"})
+		assert.NoError(t, err)
+		assert.Len(t, suppressions, 1)
+		assert.Equal(t, testCustomerEmail, suppressions[0].Email)
+		assert.Equal(t, "", next)
+	})
+
+	t.Run("next offset", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockListESPSuppressions(http.StatusOK, "",
+			`{"suppressions":[{"email":"bob@example.com"},{"email":"jane@example.com"}]}`,
+		)
+
+		var next string
+		_, next, err = client.ListESPSuppressions(ESPSuppressionBounces, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "2", next)
+	})
+
+	t.Run("invalid suppression type", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, _, err = client.ListESPSuppressions("unknown", nil)
+		checkParamError(t, err, "suppressionType")
+	})
+
+	t.Run("invalid start", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		_, _, err = client.ListESPSuppressions(ESPSuppressionBounces, &ListOptions{Start: "abc"})
+		checkParamError(t, err, "start")
+	})
+
+	t.Run("customerIo error", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockListESPSuppressions(http.StatusUnauthorized, "", "")
+
+		_, _, err = client.ListESPSuppressions(ESPSuppressionBounces, nil)
+		assert.Error(t, err)
+	})
+}
+
+// TestClient_ESPSuppressionsPaginator will test the method ESPSuppressionsPaginator()
+func TestClient_ESPSuppressionsPaginator(t *testing.T) {
+	// t.Parallel() (Cannot run in parallel - issues with overriding the mock client)
+
+	t.Run("follows offsets", func(t *testing.T) {
+		client, err := newTestClient()
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+
+		mockListESPSuppressions(http.StatusOK, "limit=2",
+			`{"suppressions":[{"email":"a@example.com"},{"email":"b@example.com"}]}`,
+		)
+		httpmock.RegisterResponder(http.MethodGet,
+			fmt.Sprintf("%sv1/esp/suppression/%s?limit=2&offset=2", testAppAPIURL, ESPSuppressionBounces),
+			httpmock.NewStringResponder(http.StatusOK, `{"suppressions":[{"email":"c@example.com"}]}`),
+		)
+
+		var suppressions []*ESPSuppression
+		suppressions, err = client.ESPSuppressionsPaginator(ESPSuppressionBounces, WithPageSize(2)).All(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, suppressions, 3)
+		assert.Equal(t, 2, httpmock.GetTotalCallCount())
+	})
+}
+
+// ExampleClient_ListESPSuppressions example using ListESPSuppressions()
+//
+// See more examples in /examples/
+func ExampleClient_ListESPSuppressions() {
+
+	// Load the client
+	client, err := newTestClient()
+	if err != nil {
+		fmt.Printf("error loading client: %s", err.Error())
+		return
+	}
+
+	mockListESPSuppressions(http.StatusOK, "", `{"suppressions":[{"email":"bob@example.com"}]}`)
+
+	// List the bounced emails
+	var suppressions []*ESPSuppression
+	if suppressions, _, err = client.ListESPSuppressions(ESPSuppressionBounces, nil); err != nil {
+		fmt.Printf("error listing suppressions: %s", err.Error())
+		return
+	}
+	fmt.Printf("suppressed: %s", suppressions[0].Email)
+	// Output:suppressed: bob@example.com
+}
+
+// mockListESPSuppressions is used for mocking the response
+func mockListESPSuppressions(statusCode int, query, body string) {
+	httpmock.Reset()
+	requestURL := fmt.Sprintf("%sv1/esp/suppression/%s", testAppAPIURL, ESPSuppressionBounces)
+	if query != "" {
+		requestURL += "?" + query
+	}
+	httpmock.RegisterResponder(http.MethodGet, requestURL,
+		httpmock.NewStringResponder(
+			statusCode, body,
+		),
+	)
+}
@@ -0,0 +1,231 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+// ErrBodyTooLarge is the error if the payload exceeds the max body size
+var ErrBodyTooLarge = errors.New("webhook body too large")
+
+// Defaults for the handler
+const (
+	defaultMaxBodySize = 1 << 20 // Default max size of a payload in bytes (1MB)
+)
+
+// handlerOptions holds all the configuration for the handler
+type handlerOptions struct {
+	maxBodySize int64                                                  // Max size of a payload in bytes
+	onError     func(r *http.Request, err error)                       // Called when a request fails
+	tolerance   time.Duration                                          // Max age (or clock skew) of the timestamp
+	now         func() time.Time                                       // Current time (used for testing)
+	unhandled   func(ctx context.Context, e *Event, body []byte) error // Called for events without a callback
+}
+
+// HandlerOps allow functional options to be supplied
+// that overwrite default handler options.
+type HandlerOps func(h *handlerOptions)
+
+// WithTolerance will overwrite the max age (or clock skew) allowed for the timestamp.
+// Zero or less disables the check.
+// Default is 5 minutes.
+func WithTolerance(tolerance time.Duration) HandlerOps {
+	return func(h *handlerOptions) {
+		h.tolerance = tolerance
+	}
+}
+
+// WithMaxBodySize will overwrite the max size of a payload.
+// Default is 1MB.
+func WithMaxBodySize(size int64) HandlerOps {
+	return func(h *handlerOptions) {
+		h.maxBodySize = size
+	}
+}
+
+// WithErrorHandler will set the callback used when a request fails
+// (verification, decoding or an error returned from a callback)
+func WithErrorHandler(fn func(r *http.Request, err error)) HandlerOps {
+	return func(h *handlerOptions) {
+		h.onError = fn
+	}
+}
+
+// WithUnhandled will set the callback used for events that do not have a registered callback
+func WithUnhandled(fn func(ctx context.Context, e *Event, body []byte) error) HandlerOps {
+	return func(h *handlerOptions) {
+		h.unhandled = fn
+	}
+}
+
+// Handler is an http.Handler that verifies, decodes and dispatches reporting webhook events
+//
+// Register callbacks before serving requests, the handler is not safe for registering
+// callbacks concurrently with ServeHTTP
+type Handler struct {
+	onCustomer func(ctx context.Context, e *CustomerEvent) error
+	onEmail    func(ctx context.Context, e *EmailEvent) error
+	onPush     func(ctx context.Context, e *PushEvent) error
+	onSlack    func(ctx context.Context, e *SlackEvent) error
+	onSMS      func(ctx context.Context, e *SMSEvent) error
+	onWebhook  func(ctx context.Context, e *WebhookEvent) error
+	options    *handlerOptions
+	signingKey string
+}
+
+// NewHandler creates a new handler using the webhook signing key
+// See: https://fly.customer.io/settings/webhooks
+func NewHandler(signingKey string, opts ...HandlerOps) *Handler {
+	options := &handlerOptions{
+		maxBodySize: defaultMaxBodySize,
+		now:         time.Now,
+		tolerance:   DefaultTolerance,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &Handler{
+		options:    options,
+		signingKey: signingKey,
+	}
+}
+
+// OnCustomer will set the callback for customer events
+func (h *Handler) OnCustomer(fn func(ctx context.Context, e *CustomerEvent) error) {
+	h.onCustomer = fn
+}
+
+// OnEmail will set the callback for email events
+func (h *Handler) OnEmail(fn func(ctx context.Context, e *EmailEvent) error) {
+	h.onEmail = fn
+}
+
+// OnPush will set the callback for push events
+func (h *Handler) OnPush(fn func(ctx context.Context, e *PushEvent) error) {
+	h.onPush = fn
+}
+
+// OnSlack will set the callback for Slack events
+func (h *Handler) OnSlack(fn func(ctx context.Context, e *SlackEvent) error) {
+	h.onSlack = fn
+}
+
+// OnSMS will set the callback for SMS events
+func (h *Handler) OnSMS(fn func(ctx context.Context, e *SMSEvent) error) {
+	h.onSMS = fn
+}
+
+// OnWebhook will set the callback for webhook events
+func (h *Handler) OnWebhook(fn func(ctx context.Context, e *WebhookEvent) error) {
+	h.onWebhook = fn
+}
+
+// ServeHTTP will verify the request, decode the event and dispatch it to the callback
+//
+// Responds with 401 if the signature is invalid, 400 if the payload cannot be decoded
+// and 500 if the callback returns an error (Customer.io will retry the event)
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Read the body (limited)
+	body, err := io.ReadAll(io.LimitReader(r.Body, h.options.maxBodySize+1))
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	} else if int64(len(body)) > h.options.maxBodySize {
+		h.fail(w, r, http.StatusRequestEntityTooLarge, ErrBodyTooLarge)
+		return
+	}
+
+	// Verify the signature
+	if err = verify(
+		h.signingKey,
+		r.Header.Get(HeaderSignature),
+		r.Header.Get(HeaderTimestamp),
+		body,
+		h.options.tolerance,
+		h.options.now(),
+	); err != nil {
+		h.fail(w, r, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Decode and dispatch
+	var decoded bool
+	if decoded, err = h.dispatch(r.Context(), body); err != nil {
+		if !decoded {
+			h.fail(w, r, http.StatusBadRequest, err)
+		} else {
+			h.fail(w, r, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// dispatch will decode the body into the typed event and fire the callback
+// Returns false if the body could not be decoded
+func (h *Handler) dispatch(ctx context.Context, body []byte) (bool, error) {
+	var e Event
+	if err := json.Unmarshal(body, &e); err != nil {
+		return false, err
+	}
+
+	switch {
+	case e.ObjectType == ObjectTypeCustomer && h.onCustomer != nil:
+		var event CustomerEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			return false, err
+		}
+		return true, h.onCustomer(ctx, &event)
+	case e.ObjectType == ObjectTypeEmail && h.onEmail != nil:
+		var event EmailEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			return false, err
+		}
+		return true, h.onEmail(ctx, &event)
+	case e.ObjectType == ObjectTypePush && h.onPush != nil:
+		var event PushEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			return false, err
+		}
+		return true, h.onPush(ctx, &event)
+	case e.ObjectType == ObjectTypeSlack && h.onSlack != nil:
+		var event SlackEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			return false, err
+		}
+		return true, h.onSlack(ctx, &event)
+	case e.ObjectType == ObjectTypeSMS && h.onSMS != nil:
+		var event SMSEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			return false, err
+		}
+		return true, h.onSMS(ctx, &event)
+	case e.ObjectType == ObjectTypeWebhook && h.onWebhook != nil:
+		var event WebhookEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			return false, err
+		}
+		return true, h.onWebhook(ctx, &event)
+	case h.options.unhandled != nil:
+		return true, h.options.unhandled(ctx, &e, body)
+	}
+	return true, nil
+}
+
+// fail will respond with the status code and fire the error callback
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, statusCode int, err error) {
+	if h.options.onError != nil {
+		h.options.onError(r, err)
+	}
+	http.Error(w, http.StatusText(statusCode), statusCode)
+}
@@ -0,0 +1,192 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestRequest will return a signed webhook request
+func newTestRequest(body []byte) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/customerio", bytes.NewReader(body))
+	req.Header.Set(HeaderSignature, Sign(testSigningKey, testTimestamp, body))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(testTimestamp, 10))
+	return req
+}
+
+// newTestHandler will return a handler using a fixed time
+func newTestHandler(opts ...HandlerOps) *Handler {
+	h := NewHandler(testSigningKey, opts...)
+	h.options.now = func() time.Time { return time.Unix(testTimestamp, 0) }
+	return h
+}
+
+// TestHandler_ServeHTTP will test the method ServeHTTP()
+func TestHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	t.Run("email event", func(t *testing.T) {
+		h := newTestHandler()
+		var received *EmailEvent
+		h.OnEmail(func(_ context.Context, e *EmailEvent) error {
+			received = e
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newTestRequest(testBody))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotNil(t, received)
+		assert.Equal(t, MetricSent, received.Metric)
+		assert.Equal(t, ObjectTypeEmail, received.ObjectType)
+		assert.Equal(t, "01E2EMRMM6TZ12TF9WGZN0WJQT", received.EventID)
+		assert.Equal(t, "bob@example.com", received.Data.Recipient)
+		assert.Equal(t, "Welcome!", received.Data.Subject)
+		assert.Equal(t, int64(9), received.Data.CampaignID)
+		assert.Equal(t, "123", received.Data.Identifiers["id"])
+	})
+
+	t.Run("push event", func(t *testing.T) {
+		h := newTestHandler()
+		var received *PushEvent
+		h.OnPush(func(_ context.Context, e *PushEvent) error {
+			received = e
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newTestRequest([]byte(`{"data":{"delivery_id":"abc","recipients":[`+
+			`{"device_id":"device-1","device_platform":"ios"}]},"event_id":"1","metric":"delivered",`+
+			`"object_type":"push","timestamp":1613063089}`)))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotNil(t, received)
+		assert.Equal(t, MetricDelivered, received.Metric)
+		assert.Len(t, received.Data.Recipients, 1)
+		assert.Equal(t, "device-1", received.Data.Recipients[0].DeviceID)
+	})
+
+	t.Run("sms, slack, webhook and customer events", func(t *testing.T) {
+		h := newTestHandler()
+		var received []ObjectType
+		h.OnSMS(func(_ context.Context, e *SMSEvent) error {
+			received = append(received, e.ObjectType)
+			return nil
+		})
+		h.OnSlack(func(_ context.Context, e *SlackEvent) error {
+			received = append(received, e.ObjectType)
+			return nil
+		})
+		h.OnWebhook(func(_ context.Context, e *WebhookEvent) error {
+			received = append(received, e.ObjectType)
+			return nil
+		})
+		h.OnCustomer(func(_ context.Context, e *CustomerEvent) error {
+			received = append(received, e.ObjectType)
+			assert.Equal(t, "bob@example.com", e.Data.EmailAddress)
+			return nil
+		})
+
+		for _, body := range []string{
+			`{"data":{"delivery_id":"1","recipient":"+15555555555"},"metric":"sent","object_type":"sms"}`,
+			`{"data":{"delivery_id":"2","recipient":"#general"},"metric":"sent","object_type":"slack"}`,
+			`{"data":{"delivery_id":"3","recipient":"https://example.com"},"metric":"sent","object_type":"webhook"}`,
+			`{"data":{"customer_id":"123","email_address":"bob@example.com"},"metric":"unsubscribed","object_type":"customer"}`,
+		} {
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, newTestRequest([]byte(body)))
+			assert.Equal(t, http.StatusOK, w.Code)
+		}
+		assert.Equal(t, []ObjectType{ObjectTypeSMS, ObjectTypeSlack, ObjectTypeWebhook, ObjectTypeCustomer}, received)
+	})
+
+	t.Run("unhandled event", func(t *testing.T) {
+		var unhandled *Event
+		h := newTestHandler(WithUnhandled(func(_ context.Context, e *Event, _ []byte) error {
+			unhandled = e
+			return nil
+		}))
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newTestRequest(testBody))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotNil(t, unhandled)
+		assert.Equal(t, ObjectTypeEmail, unhandled.ObjectType)
+	})
+
+	t.Run("no callbacks", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newTestHandler().ServeHTTP(w, newTestRequest(testBody))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		var handlerErr error
+		h := newTestHandler(WithErrorHandler(func(_ *http.Request, err error) {
+			handlerErr = err
+		}))
+		h.OnEmail(func(_ context.Context, _ *EmailEvent) error {
+			t.Error("callback should not be called")
+			return nil
+		})
+
+		req := newTestRequest(testBody)
+		req.Header.Set(HeaderSignature, Sign("wrong", testTimestamp, testBody))
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.ErrorIs(t, handlerErr, ErrInvalidSignature)
+	})
+
+	t.Run("expired timestamp", func(t *testing.T) {
+		h := NewHandler(testSigningKey)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newTestRequest(testBody))
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newTestHandler().ServeHTTP(w, newTestRequest([]byte(`{"metric":`)))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("body too large", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newTestHandler(WithMaxBodySize(10)).ServeHTTP(w, newTestRequest(testBody))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("callback error", func(t *testing.T) {
+		h := newTestHandler()
+		h.OnEmail(func(_ context.Context, _ *EmailEvent) error {
+			return errors.New("database is down")
+		})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newTestRequest(testBody))
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("invalid method", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newTestHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/webhooks/customerio", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
+
+// BenchmarkHandler_ServeHTTP benchmarks the method ServeHTTP()
+func BenchmarkHandler_ServeHTTP(b *testing.B) {
+	h := newTestHandler()
+	h.OnEmail(func(_ context.Context, _ *EmailEvent) error { return nil })
+	for i := 0; i < b.N; i++ {
+		h.ServeHTTP(httptest.NewRecorder(), newTestRequest(testBody))
+	}
+}
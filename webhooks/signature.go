@@ -0,0 +1,75 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Headers sent by Customer.io on all reporting webhooks
+const (
+	HeaderSignature = "X-CIO-Signature"
+	HeaderTimestamp = "X-CIO-Timestamp"
+)
+
+// DefaultTolerance is the default max age (or clock skew) allowed for the timestamp
+const DefaultTolerance = 5 * time.Minute
+
+// Errors returned if a payload cannot be verified
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrInvalidTimestamp = errors.New("invalid webhook timestamp")
+	ErrMissingSignature = errors.New("missing webhook signature")
+	ErrMissingTimestamp = errors.New("missing webhook timestamp")
+	ErrTimestampExpired = errors.New("webhook timestamp outside of tolerance")
+)
+
+// Sign will return the hex encoded HMAC-SHA256 signature for the payload
+// The signed content is: "v0:" + timestamp + ":" + body
+func Sign(signingKey string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(signingKey))
+	_, _ = fmt.Fprintf(mac, "v0:%d:", timestamp)
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify will check the signature and timestamp (from the headers) against the body
+// The signatures are compared in constant time
+//
+// If tolerance is zero or less, the age of the timestamp is not checked
+func Verify(signingKey, signature, timestamp string, body []byte, tolerance time.Duration) error {
+	return verify(signingKey, signature, timestamp, body, tolerance, time.Now())
+}
+
+// verify is Verify() using the given time as "now"
+func verify(signingKey, signature, timestamp string, body []byte, tolerance time.Duration, now time.Time) error {
+	if signature == "" {
+		return ErrMissingSignature
+	} else if timestamp == "" {
+		return ErrMissingTimestamp
+	}
+
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+	if tolerance > 0 {
+		if diff := now.Sub(time.Unix(ts, 0)); diff > tolerance || diff < -tolerance {
+			return ErrTimestampExpired
+		}
+	}
+
+	var given []byte
+	if given, err = hex.DecodeString(signature); err != nil {
+		return ErrInvalidSignature
+	}
+	expected, _ := hex.DecodeString(Sign(signingKey, ts, body))
+	if !hmac.Equal(given, expected) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
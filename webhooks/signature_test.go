@@ -0,0 +1,113 @@
+package webhooks
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testSigningKey = "TestSigningKey1234567"
+	testTimestamp  = int64(1613063089)
+)
+
+// testBody is an example email event payload
+var testBody = []byte(`{"data":{"action_id":36,"campaign_id":9,"customer_id":"123",` +
+	`"delivery_id":"RAECAAFwnUSneIa0ZXkmq8EdkAM==","identifiers":{"id":"123"},` +
+	`"recipient":"bob@example.com","subject":"Welcome!"},` +
+	`"event_id":"01E2EMRMM6TZ12TF9WGZN0WJQT","metric":"sent","object_type":"email","timestamp":1613063089}`)
+
+// TestSign will test the method Sign()
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	signature := Sign(testSigningKey, testTimestamp, testBody)
+	assert.Len(t, signature, 64)
+	assert.Equal(t, signature, Sign(testSigningKey, testTimestamp, testBody))
+	assert.NotEqual(t, signature, Sign(testSigningKey+"456", testTimestamp, testBody))
+	assert.NotEqual(t, signature, Sign(testSigningKey, testTimestamp+1, testBody))
+}
+
+// TestVerify will test the method Verify()
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(testTimestamp, 0)
+	timestamp := strconv.FormatInt(testTimestamp, 10)
+	signature := Sign(testSigningKey, testTimestamp, testBody)
+
+	t.Run("valid signature", func(t *testing.T) {
+		assert.NoError(t, verify(testSigningKey, signature, timestamp, testBody, DefaultTolerance, now))
+	})
+
+	t.Run("no tolerance", func(t *testing.T) {
+		assert.NoError(t, Verify(testSigningKey, signature, timestamp, testBody, 0))
+	})
+
+	t.Run("missing signature", func(t *testing.T) {
+		err := verify(testSigningKey, "", timestamp, testBody, DefaultTolerance, now)
+		assert.ErrorIs(t, err, ErrMissingSignature)
+	})
+
+	t.Run("missing timestamp", func(t *testing.T) {
+		err := verify(testSigningKey, signature, "", testBody, DefaultTolerance, now)
+		assert.ErrorIs(t, err, ErrMissingTimestamp)
+	})
+
+	t.Run("invalid timestamp", func(t *testing.T) {
+		err := verify(testSigningKey, signature, "yesterday", testBody, DefaultTolerance, now)
+		assert.ErrorIs(t, err, ErrInvalidTimestamp)
+	})
+
+	t.Run("expired timestamp", func(t *testing.T) {
+		err := verify(testSigningKey, signature, timestamp, testBody, DefaultTolerance, now.Add(time.Hour))
+		assert.ErrorIs(t, err, ErrTimestampExpired)
+	})
+
+	t.Run("timestamp in the future", func(t *testing.T) {
+		err := verify(testSigningKey, signature, timestamp, testBody, DefaultTolerance, now.Add(-time.Hour))
+		assert.ErrorIs(t, err, ErrTimestampExpired)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		err := verify(testSigningKey, Sign("wrong", testTimestamp, testBody), timestamp, testBody, DefaultTolerance, now)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("signature is not hex", func(t *testing.T) {
+		err := verify(testSigningKey, "not-hex", timestamp, testBody, DefaultTolerance, now)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("modified body", func(t *testing.T) {
+		err := verify(testSigningKey, signature, timestamp, append(testBody, ' '), DefaultTolerance, now)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+}
+
+// ExampleVerify example using Verify()
+func ExampleVerify() {
+	timestamp := time.Now().Unix()
+	signature := Sign(testSigningKey, timestamp, testBody)
+
+	if err := Verify(
+		testSigningKey, signature, strconv.FormatInt(timestamp, 10), testBody, DefaultTolerance,
+	); err != nil {
+		fmt.Printf("error verifying webhook: %s", err.Error())
+		return
+	}
+	fmt.Printf("webhook verified")
+	// Output:webhook verified
+}
+
+// BenchmarkVerify benchmarks the method Verify()
+func BenchmarkVerify(b *testing.B) {
+	timestamp := strconv.FormatInt(testTimestamp, 10)
+	signature := Sign(testSigningKey, testTimestamp, testBody)
+	for i := 0; i < b.N; i++ {
+		_ = Verify(testSigningKey, signature, timestamp, testBody, 0)
+	}
+}
@@ -0,0 +1,150 @@
+// Package webhooks is used for receiving Customer.io reporting webhooks
+// following their documentation: https://customer.io/docs/api/app/#tag/Reporting-Webhooks
+//
+// Payloads are verified using the X-CIO-Signature and X-CIO-Timestamp headers
+// and decoded into typed events for each object type (email, push, sms, slack, webhook, customer)
+package webhooks
+
+// ObjectType is the type of object the reporting webhook event is for
+type ObjectType string
+
+// Allowed object types
+const (
+	ObjectTypeCustomer ObjectType = "customer"
+	ObjectTypeEmail    ObjectType = "email"
+	ObjectTypeInApp    ObjectType = "in_app"
+	ObjectTypePush     ObjectType = "push"
+	ObjectTypeSlack    ObjectType = "slack"
+	ObjectTypeSMS      ObjectType = "sms"
+	ObjectTypeWebhook  ObjectType = "webhook"
+)
+
+// Metric is the metric that triggered the reporting webhook event
+type Metric string
+
+// Allowed metrics (not every metric applies to every object type)
+const (
+	MetricAttempted          Metric = "attempted"
+	MetricBounced            Metric = "bounced"
+	MetricClicked            Metric = "clicked"
+	MetricConverted          Metric = "converted"
+	MetricDelivered          Metric = "delivered"
+	MetricDrafted            Metric = "drafted"
+	MetricDropped            Metric = "dropped"
+	MetricFailed             Metric = "failed"
+	MetricOpened             Metric = "opened"
+	MetricSent               Metric = "sent"
+	MetricSpammed            Metric = "spammed"
+	MetricSubscribed         Metric = "subscribed"
+	MetricSubscriptionChange Metric = "cio_subscription_preferences_changed"
+	MetricUndeliverable      Metric = "undeliverable"
+	MetricUnsubscribed       Metric = "unsubscribed"
+)
+
+// Event is the standard fields sent on all reporting webhook events
+type Event struct {
+	EventID    string     `json:"event_id"`    // Unique id for the event (use for deduplication)
+	Metric     Metric     `json:"metric"`      // Metric that triggered the event
+	ObjectType ObjectType `json:"object_type"` // Type of object the event is for
+	Timestamp  int64      `json:"timestamp"`   // Unix timestamp of the event
+}
+
+// MessageData is the standard fields for all message events (email, push, sms, slack, webhook)
+type MessageData struct {
+	ActionID               int64             `json:"action_id,omitempty"`
+	BroadcastID            int64             `json:"broadcast_id,omitempty"`
+	CampaignID             int64             `json:"campaign_id,omitempty"`
+	CustomerID             string            `json:"customer_id,omitempty"`
+	DeliveryID             string            `json:"delivery_id"`
+	FailureMessage         string            `json:"failure_message,omitempty"`
+	Href                   string            `json:"href,omitempty"`
+	Identifiers            map[string]string `json:"identifiers,omitempty"`
+	JourneyID              string            `json:"journey_id,omitempty"`
+	LinkID                 int64             `json:"link_id,omitempty"`
+	NewsletterID           int64             `json:"newsletter_id,omitempty"`
+	ParentActionID         int64             `json:"parent_action_id,omitempty"`
+	TransactionalMessageID int64             `json:"transactional_message_id,omitempty"`
+	TriggerEventID         string            `json:"trigger_event_id,omitempty"`
+}
+
+// EmailData is the data for an email event
+type EmailData struct {
+	MessageData
+	Recipient string `json:"recipient"`
+	Subject   string `json:"subject,omitempty"`
+}
+
+// EmailEvent is a reporting webhook event for an email
+type EmailEvent struct {
+	Event
+	Data EmailData `json:"data"`
+}
+
+// PushRecipient is a device that a push notification was sent to
+type PushRecipient struct {
+	DeviceID       string `json:"device_id"`
+	DevicePlatform string `json:"device_platform"`
+}
+
+// PushData is the data for a push event
+type PushData struct {
+	MessageData
+	Recipients []PushRecipient `json:"recipients,omitempty"`
+}
+
+// PushEvent is a reporting webhook event for a push notification
+type PushEvent struct {
+	Event
+	Data PushData `json:"data"`
+}
+
+// SMSData is the data for an SMS event
+type SMSData struct {
+	MessageData
+	Recipient string `json:"recipient"`
+}
+
+// SMSEvent is a reporting webhook event for an SMS
+type SMSEvent struct {
+	Event
+	Data SMSData `json:"data"`
+}
+
+// SlackData is the data for a Slack event
+type SlackData struct {
+	MessageData
+	Recipient string `json:"recipient"`
+}
+
+// SlackEvent is a reporting webhook event for a Slack message
+type SlackEvent struct {
+	Event
+	Data SlackData `json:"data"`
+}
+
+// WebhookData is the data for a webhook event
+type WebhookData struct {
+	MessageData
+	Recipient string `json:"recipient"` // The URL the webhook was sent to
+}
+
+// WebhookEvent is a reporting webhook event for a (campaign) webhook
+type WebhookEvent struct {
+	Event
+	Data WebhookData `json:"data"`
+}
+
+// CustomerData is the data for a customer (subscription) event
+type CustomerData struct {
+	Content      string            `json:"content,omitempty"`
+	CustomerID   string            `json:"customer_id,omitempty"`
+	DeliveryID   string            `json:"delivery_id,omitempty"`
+	EmailAddress string            `json:"email_address,omitempty"`
+	Identifiers  map[string]string `json:"identifiers,omitempty"`
+}
+
+// CustomerEvent is a reporting webhook event for a customer (subscribed, unsubscribed, preferences changed)
+type CustomerEvent struct {
+	Event
+	Data CustomerData `json:"data"`
+}